@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// cacheDir give the directory of cached config files:
+// $XDG_CACHE_HOME/download-geofabrik or ~/.cache/download-geofabrik
+func cacheDir() string {
+	dir := os.Getenv("XDG_CACHE_HOME")
+	if dir == "" {
+		home := os.Getenv("HOME")
+		if home == "" {
+			return ""
+		}
+		dir = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(dir, "download-geofabrik")
+}
+
+// cacheFile give the cached file of a service config file.
+// Return "" if configFile is not a service config file.
+func cacheFile(configFile string) string {
+	name := filepath.Clean(configFile)
+	for _, serviceConfig := range serviceConfigs {
+		if name == serviceConfig && cacheDir() != "" {
+			return filepath.Join(cacheDir(), name)
+		}
+	}
+	return ""
+}
+
+// cacheExpired is true if c was generated more than ttl ago.
+// A ttl of 0 never expire.
+func cacheExpired(c *Config, ttl time.Duration, now time.Time) bool {
+	return ttl > 0 && !c.Generated.IsZero() && now.Sub(c.Generated) > ttl
+}
+
+// refreshCache generate the config of service into the cache.
+func refreshCache(ctx context.Context, service string) (string, error) {
+	name, ok := serviceConfigs[service]
+	if !ok {
+		return "", fmt.Errorf("unknown service %s", service)
+	}
+	filename := cacheFile(name)
+	if filename == "" {
+		return "", fmt.Errorf("no cache directory, please set XDG_CACHE_HOME or HOME")
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return "", err
+	}
+	oldService := *fService
+	*fService = service
+	defer func() { *fService = oldService }()
+	return filename, Generate(ctx, filename)
+}
+
+// checkCache warn or refresh if configFile come from an expired cache.
+func checkCache(ctx context.Context, configFile string) error {
+	filename := cacheFile(configFile)
+	if filename == "" || fileExist(configFile) || !fileExist(filename) {
+		return nil // cache not used
+	}
+	c, err := loadConfig(filename)
+	if err != nil || !cacheExpired(c, *fCacheTTL, time.Now()) {
+		return nil // errors will be catched when loading configFile
+	}
+	if *fAutoRefresh {
+		if !*fQuiet {
+			log.Println("Refreshing", filename)
+		}
+		_, err = refreshCache(ctx, *fService)
+		return err
+	}
+	if !*fQuiet {
+		log.Printf("Warning: %s was generated %s, use 'download-geofabrik config refresh' or --auto-refresh",
+			filename, c.Generated.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// askYesNo write question in w and read the answer from r.
+func askYesNo(r io.Reader, w io.Writer, question string) bool {
+	fmt.Fprintf(w, "%s [y/N] ", question)
+	answer, _ := bufio.NewReader(r).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// isTerminal is true if f is a terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// offerRegenerate ask user if config must be regenerated.
+// Only asked on a terminal.
+func offerRegenerate() bool {
+	if *fQuiet || !isTerminal(os.Stdin) {
+		return false
+	}
+	return askYesNo(os.Stdin, os.Stderr, "Config file may be outdated, regenerate it now?")
+}
+
+// regenerateConfig generate the config file used, or its cache if it's not on disk.
+func regenerateConfig(ctx context.Context) (*Config, error) {
+	var err error
+	if fileExist(*fConfig) || cacheFile(*fConfig) == "" {
+		err = Generate(ctx, *fConfig)
+	} else {
+		_, err = refreshCache(ctx, *fService)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return loadConfig(*fConfig)
+}
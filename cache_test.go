@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_cacheDir(t *testing.T) {
+	tests := []struct {
+		name string
+		xdg  string
+		home string
+		want string
+	}{
+		{name: "xdg", xdg: "/xdg", home: "/home/me", want: "/xdg/download-geofabrik"},
+		{name: "home", xdg: "", home: "/home/me", want: "/home/me/.cache/download-geofabrik"},
+		{name: "nothing", xdg: "", home: "", want: ""},
+	}
+	oldXDG, oldHome := os.Getenv("XDG_CACHE_HOME"), os.Getenv("HOME")
+	defer os.Setenv("XDG_CACHE_HOME", oldXDG)
+	defer os.Setenv("HOME", oldHome)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv("XDG_CACHE_HOME", tt.xdg)
+			os.Setenv("HOME", tt.home)
+			if got := cacheDir(); got != tt.want {
+				t.Errorf("cacheDir() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cacheFile(t *testing.T) {
+	oldXDG := os.Getenv("XDG_CACHE_HOME")
+	defer os.Setenv("XDG_CACHE_HOME", oldXDG)
+	os.Setenv("XDG_CACHE_HOME", "/xdg")
+	tests := []struct {
+		name       string
+		configFile string
+		want       string
+	}{
+		{name: "default config", configFile: "./geofabrik.yml", want: "/xdg/download-geofabrik/geofabrik.yml"},
+		{name: "gislab", configFile: "gislab.yml", want: "/xdg/download-geofabrik/gislab.yml"},
+		{name: "not in current dir", configFile: "/etc/geofabrik.yml", want: ""},
+		{name: "not a service config", configFile: "./my.yml", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cacheFile(tt.configFile); got != tt.want {
+				t.Errorf("cacheFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cacheExpired(t *testing.T) {
+	now := time.Date(2019, 4, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		generated time.Time
+		ttl       time.Duration
+		want      bool
+	}{
+		{name: "fresh", generated: now.Add(-time.Hour), ttl: 24 * time.Hour, want: false},
+		{name: "expired", generated: now.Add(-48 * time.Hour), ttl: 24 * time.Hour, want: true},
+		{name: "ttl disabled", generated: now.Add(-48 * time.Hour), ttl: 0, want: false},
+		{name: "unknown date", ttl: 24 * time.Hour, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cacheExpired(&Config{Generated: tt.generated}, tt.ttl, now); got != tt.want {
+				t.Errorf("cacheExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_loadConfig_cache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	oldXDG := os.Getenv("XDG_CACHE_HOME")
+	defer os.Setenv("XDG_CACHE_HOME", oldXDG)
+	os.Setenv("XDG_CACHE_HOME", filepath.Join(dir, "cache"))
+	pwd, _ := os.Getwd()
+	defer os.Chdir(pwd)
+	os.Chdir(dir)
+
+	c, err := loadConfig("./gislab.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.BaseURL != "http://be.gis-lab.info/project/osm_dump" {
+		t.Errorf("loadConfig().BaseURL = %v, want embedded config", c.BaseURL)
+	}
+	os.MkdirAll(filepath.Join(dir, "cache", "download-geofabrik"), 0755)
+	ioutil.WriteFile(cacheFile("gislab.yml"), []byte("baseURL: https://cached.url\ngenerated: 2019-04-10T12:00:00Z\n"), 0644)
+	c, err = loadConfig("./gislab.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.BaseURL != "https://cached.url" || !c.Generated.Equal(time.Date(2019, 4, 10, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("loadConfig() = %+v, want cached config", c)
+	}
+	ioutil.WriteFile("gislab.yml", []byte("baseURL: https://my.base.url\n"), 0644)
+	c, err = loadConfig("./gislab.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.BaseURL != "https://my.base.url" {
+		t.Errorf("loadConfig().BaseURL = %v, file on disk must be used first", c.BaseURL)
+	}
+}
+
+func Test_askYesNo(t *testing.T) {
+	tests := []struct {
+		name   string
+		answer string
+		want   bool
+	}{
+		{name: "y", answer: "y\n", want: true},
+		{name: "Yes", answer: " Yes\n", want: true},
+		{name: "n", answer: "n\n", want: false},
+		{name: "empty", answer: "\n", want: false},
+		{name: "EOF", answer: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w bytes.Buffer
+			if got := askYesNo(strings.NewReader(tt.answer), &w, "Regenerate?"); got != tt.want {
+				t.Errorf("askYesNo() = %v, want %v", got, tt.want)
+			}
+			if w.String() != "Regenerate? [y/N] " {
+				t.Errorf("askYesNo() write %q", w.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
+)
+
+// userAgent is the default User-Agent, see --user-agent.
+const userAgent = "download-geofabrik/" + version
+
+// requestHeader give headers sent with every request: --header and --user-agent.
+// Headers use the format "Name: value".
+func requestHeader() (http.Header, error) {
+	header := make(http.Header)
+	for _, h := range *fHeaders {
+		i := strings.Index(h, ":")
+		if i < 1 {
+			return nil, fmt.Errorf("Wrong header %q, please use format \"Name: value\"", h)
+		}
+		header.Add(strings.TrimSpace(h[:i]), strings.TrimSpace(h[i+1:]))
+	}
+	if header.Get("User-Agent") == "" {
+		ua := *fUserAgent
+		if ua == "" {
+			ua = userAgent
+		}
+		header.Set("User-Agent", ua)
+	}
+	return header, nil
+}
+
+// tlsConfig give the TLS config using --ca-cert, --cert and --key, nil if they are not set.
+// --key may be omitted if the key is in the --cert file.
+func tlsConfig() (*tls.Config, error) {
+	if *fCACert == "" && *fCert == "" {
+		return nil, nil
+	}
+	config := &tls.Config{}
+	if *fCACert != "" {
+		pem, err := ioutil.ReadFile(*fCACert)
+		if err != nil {
+			return nil, fmt.Errorf("Can't read CA bundle: %v", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("No certificate found in %s", *fCACert)
+		}
+		config.RootCAs = pool
+	}
+	if *fCert != "" {
+		key := *fKey
+		if key == "" {
+			key = *fCert
+		}
+		cert, err := tls.LoadX509KeyPair(*fCert, key)
+		if err != nil {
+			return nil, fmt.Errorf("Can't load client certificate: %v", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// idleConn is a net.Conn failing when nothing is read during timeout.
+type idleConn struct {
+	net.Conn
+	timeout time.Duration
+}
+
+func (c *idleConn) Read(p []byte) (int, error) {
+	if err := c.Conn.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+	return c.Conn.Read(p)
+}
+
+// dialer give a DialContext using --connect-timeout and --read-timeout.
+func dialer() func(ctx context.Context, network, addr string) (net.Conn, error) {
+	d := &net.Dialer{Timeout: *fConnTimeout, KeepAlive: 30 * time.Second}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := d.DialContext(ctx, network, addr)
+		if err != nil || *fReadTimeout <= 0 {
+			return conn, err
+		}
+		return &idleConn{Conn: conn, timeout: *fReadTimeout}, nil
+	}
+}
+
+// headerTransport add header to every request.
+type headerTransport struct {
+	http.RoundTripper
+	header http.Header
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := new(http.Request) // RoundTrip must not modify req
+	*r = *req
+	r.Header = make(http.Header, len(req.Header)+len(t.header))
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	for k, v := range t.header {
+		r.Header[k] = v
+	}
+	return t.RoundTripper.RoundTrip(r)
+}
+
+// settingsAuth are credentials of the settings file by service.
+var settingsAuth map[string][]geofabrik.Auth
+
+// clientAuths give credentials of --cookies, of --service in settings, then those of c.
+// --cookies are only sent to internalHost, with --service geofabrik-internal.
+// Credentials without hosts are sent to the host of c BaseURL, c may be nil.
+func clientAuths(c *Config) []geofabrik.Auth {
+	var auths []geofabrik.Auth
+	if *fCookies != "" && *fService == "geofabrik-internal" {
+		auths = append(auths, geofabrik.Auth{Hosts: []string{internalHost}, Cookies: *fCookies})
+	}
+	baseURL := ""
+	if c != nil {
+		baseURL = c.BaseURL
+	}
+	for _, a := range settingsAuth[*fService] {
+		auths = append(auths, a.WithHosts(baseURL))
+	}
+	if c != nil {
+		auths = append(auths, c.Auths()...)
+	}
+	return auths
+}
+
+// internalHost is the server of geofabrik-internal, it need an OSM login.
+const internalHost = "osm-internal.download.geofabrik.de"
+
+// checkCookies tell how to login if --service is geofabrik-internal and no cookies are sent to it.
+func checkCookies(auths []geofabrik.Auth) error {
+	if *fService != "geofabrik-internal" {
+		return nil
+	}
+	host := internalHost
+	for _, a := range auths {
+		if a.Cookies != "" && stringInSlice(&host, &a.Hosts) {
+			return nil
+		}
+	}
+	return fmt.Errorf("geofabrik-internal need an OSM login, please use --cookies with a cookie file, see https://github.com/geofabrik/sendfile_osm_oauth_protector")
+}
+
+// newClient make an http.Client using proxy, timeout, header and TLS flags.
+// auths are sent to their hosts only, see clientAuths.
+// --timeout limit each request with its body, 0 for no limit.
+func newClient(auths []geofabrik.Auth) (*http.Client, error) {
+	proxy, err := proxyFunc()
+	if err != nil {
+		return nil, err
+	}
+	header, err := requestHeader()
+	if err != nil {
+		return nil, err
+	}
+	tlsClientConfig, err := tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport := &http.Transport{
+		Proxy: func(req *http.Request) (*url.URL, error) {
+			return proxy(req.URL)
+		},
+		DialContext:         dialer(),
+		TLSClientConfig:     tlsClientConfig,
+		TLSHandshakeTimeout: *fTLSTimeout,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+	}
+	authTransport, err := geofabrik.NewAuthTransport(transport, auths)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{
+		Transport: &headerTransport{RoundTripper: authTransport, header: header},
+		Timeout:   *fTimeout,
+	}, nil
+}
@@ -0,0 +1,351 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
+)
+
+// setClientFlags set header, timeout and TLS flags.
+// The returned function reset them.
+func setClientFlags(ua string, headers []string, readTimeout time.Duration, timeout time.Duration, caCert, cert, key string) func() {
+	*fUserAgent, *fHeaders, *fReadTimeout, *fTimeout, *fCACert, *fCert, *fKey = ua, headers, readTimeout, timeout, caCert, cert, key
+	return func() {
+		*fUserAgent, *fHeaders, *fReadTimeout, *fTimeout, *fCACert, *fCert, *fKey = "", nil, 0, 0, "", "", ""
+	}
+}
+
+// writePEM write blocks of type typ into file.
+func writePEM(t *testing.T, file string, typ string, blocks ...[]byte) {
+	var out []byte
+	for _, b := range blocks {
+		out = append(out, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: b})...)
+	}
+	if err := ioutil.WriteFile(file, out, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newClientCert write a self signed client certificate and its key in dir.
+func newClientCert(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "download-geofabrik test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := filepath.Join(dir, "client.pem"), filepath.Join(dir, "client.key")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDer)
+	return certFile, keyFile
+}
+
+func Test_requestHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		ua      string
+		headers []string
+		want    http.Header
+		wantErr bool
+	}{
+		{name: "default", want: http.Header{"User-Agent": {userAgent}}},
+		{name: "user-agent", ua: "my-mirror/1.0", want: http.Header{"User-Agent": {"my-mirror/1.0"}}},
+		{
+			name:    "headers",
+			ua:      userAgent,
+			headers: []string{"Authorization: Bearer token", "x-mirror:  eu ", "X-Mirror: us"},
+			want:    http.Header{"User-Agent": {userAgent}, "Authorization": {"Bearer token"}, "X-Mirror": {"eu", "us"}},
+		},
+		{name: "User-Agent header", ua: userAgent, headers: []string{"User-Agent: curl/7.64"}, want: http.Header{"User-Agent": {"curl/7.64"}}},
+		{name: "no colon", headers: []string{"Authorization"}, wantErr: true},
+		{name: "no name", headers: []string{": value"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer setClientFlags(tt.ua, tt.headers, 0, 0, "", "", "")()
+			got, err := requestHeader()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("requestHeader() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("requestHeader() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_clientAuths(t *testing.T) {
+	settingsAuth = map[string][]geofabrik.Auth{
+		"geofabrik": {{Token: "settings"}},
+		"gislab":    {{Token: "gislab"}},
+	}
+	defer func() {
+		settingsAuth = nil
+		*fService = ""
+		*fCookies = ""
+	}()
+	c := &Config{BaseURL: "https://mirror.example.com/osm", Auth: []geofabrik.Auth{{Token: "config"}}}
+	internal := &Config{
+		BaseURL: "https://" + internalHost,
+		Formats: map[string]format{"shp.zip": {ID: "shp.zip", Loc: "-latest-free.shp.zip", BaseURL: "https://download.geofabrik.de"}},
+	}
+	tests := []struct {
+		name     string
+		c        *Config
+		service  string
+		fCookies string
+		want     []geofabrik.Auth
+	}{
+		{name: "no config", service: "geofabrik", want: []geofabrik.Auth{{Token: "settings"}}},
+		{name: "config", c: c, service: "geofabrik", want: []geofabrik.Auth{
+			{Hosts: []string{"mirror.example.com"}, Token: "settings"},
+			{Hosts: []string{"mirror.example.com"}, Token: "config"},
+		}},
+		{name: "cookies of other service", c: c, service: "gislab", fCookies: "cookies.txt", want: []geofabrik.Auth{
+			{Hosts: []string{"mirror.example.com"}, Token: "gislab"},
+			{Hosts: []string{"mirror.example.com"}, Token: "config"},
+		}},
+		{name: "cookies of geofabrik-internal", c: internal, service: "geofabrik-internal", fCookies: "cookies.txt", want: []geofabrik.Auth{
+			{Hosts: []string{internalHost}, Cookies: "cookies.txt"},
+		}},
+		{name: "cookies without config", service: "geofabrik-internal", fCookies: "cookies.txt", want: []geofabrik.Auth{
+			{Hosts: []string{internalHost}, Cookies: "cookies.txt"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*fService = tt.service
+			*fCookies = tt.fCookies
+			if got := clientAuths(tt.c); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("clientAuths() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkCookies(t *testing.T) {
+	defer func() { *fService = "" }()
+	tests := []struct {
+		name    string
+		service string
+		auths   []geofabrik.Auth
+		wantErr bool
+	}{
+		{name: "geofabrik", service: "geofabrik"},
+		{name: "internal with cookies", service: "geofabrik-internal", auths: []geofabrik.Auth{{Hosts: []string{internalHost}, Cookies: "cookies.txt"}}},
+		{name: "internal without cookies", service: "geofabrik-internal", auths: []geofabrik.Auth{{Hosts: []string{internalHost}, Token: "t0ken"}}, wantErr: true},
+		{name: "internal with cookies of other host", service: "geofabrik-internal", auths: []geofabrik.Auth{{Hosts: []string{"download.geofabrik.de"}, Cookies: "cookies.txt"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*fService = tt.service
+			if err := checkCookies(tt.auths); (err != nil) != tt.wantErr {
+				t.Errorf("checkCookies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_newClient_auth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+	tests := []struct {
+		name    string
+		headers []string
+		want    string
+	}{
+		{name: "auth", want: "Bearer secret"},
+		{name: "--header first", headers: []string{"Authorization: Bearer mine"}, want: "Bearer mine"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer setClientFlags("", tt.headers, 0, 0, "", "", "")()
+			client, err := newClient([]geofabrik.Auth{{Hosts: []string{"127.0.0.1"}, Token: "secret"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := client.Get(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			if body, _ := ioutil.ReadAll(res.Body); string(body) != tt.want {
+				t.Errorf("newClient() send %q, want %q", body, tt.want)
+			}
+		})
+	}
+	if _, err := newClient([]geofabrik.Auth{{Cookies: "/this_file_not_exists"}}); err == nil {
+		t.Errorf("newClient() with a missing cookie file, want an error")
+	}
+}
+
+func Test_newClient_header(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s %s %s", r.UserAgent(), r.Header.Get("Authorization"), r.Header.Get("Range"))
+	}))
+	defer server.Close()
+	defer setClientFlags("", []string{"Authorization: Bearer token"}, 0, 0, "", "", "")()
+	client, err := newClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest("GET", server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+	req.Header.Set("Range", "bytes=0-")
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, _ := ioutil.ReadAll(res.Body)
+	if want := userAgent + " Bearer token bytes=0-"; string(body) != want {
+		t.Errorf("newClient() send %q, want %q", body, want)
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Errorf("newClient() must not change requests")
+	}
+}
+
+func Test_newClient_timeouts(t *testing.T) {
+	stall := make(chan bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "10")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "12345")
+		w.(http.Flusher).Flush()
+		<-stall // never send the end
+	}))
+	defer server.Close()
+	defer close(stall)
+	tests := []struct {
+		name        string
+		readTimeout time.Duration
+		timeout     time.Duration
+	}{
+		{name: "read-timeout", readTimeout: 100 * time.Millisecond},
+		{name: "timeout", timeout: 200 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer setClientFlags("", nil, tt.readTimeout, tt.timeout, "", "", "")()
+			client, err := newClient(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := client.Get(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			done := make(chan error, 1)
+			go func() {
+				_, err := ioutil.ReadAll(res.Body)
+				done <- err
+			}()
+			select {
+			case err := <-done:
+				if err == nil {
+					t.Errorf("newClient() read a stalled body without error")
+				}
+			case <-time.After(5 * time.Second):
+				t.Errorf("newClient() hang on a stalled body")
+			}
+		})
+	}
+}
+
+func Test_newClient_tls(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik-tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%d client certificates", len(r.TLS.PeerCertificates))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+	caFile := filepath.Join(dir, "ca.pem")
+	writePEM(t, caFile, "CERTIFICATE", server.Certificate().Raw)
+	certFile, keyFile := newClientCert(t, dir)
+	bundle := filepath.Join(dir, "bundle.pem")
+	certPEM, _ := ioutil.ReadFile(certFile)
+	keyPEM, _ := ioutil.ReadFile(keyFile)
+	if err := ioutil.WriteFile(bundle, append(certPEM, keyPEM...), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name         string
+		caCert       string
+		cert, key    string
+		want         string
+		wantErr      bool
+		wantFlagsErr bool
+	}{
+		{name: "unknown CA", cert: certFile, key: keyFile, wantErr: true},
+		{name: "no client certificate", caCert: caFile, wantErr: true},
+		{name: "client certificate", caCert: caFile, cert: certFile, key: keyFile, want: "1 client certificates"},
+		{name: "certificate and key in one file", caCert: caFile, cert: bundle, want: "1 client certificates"},
+		{name: "missing CA file", caCert: filepath.Join(dir, "missing.pem"), wantFlagsErr: true},
+		{name: "CA file without certificate", caCert: keyFile, wantFlagsErr: true},
+		{name: "certificate without key", caCert: caFile, cert: certFile, wantFlagsErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer setClientFlags("", nil, 0, 0, tt.caCert, tt.cert, tt.key)()
+			client, err := newClient(nil)
+			if (err != nil) != tt.wantFlagsErr {
+				t.Fatalf("newClient() error = %v, wantErr %v", err, tt.wantFlagsErr)
+			}
+			if tt.wantFlagsErr {
+				return
+			}
+			res, err := client.Get(server.URL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newClient() get error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			defer res.Body.Close()
+			if body, _ := ioutil.ReadAll(res.Body); string(body) != tt.want {
+				t.Errorf("newClient() get %q, want %q", body, tt.want)
+			}
+		})
+	}
+}
@@ -7,35 +7,101 @@
 package main
 
 import (
+	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 
-	yaml "gopkg.in/yaml.v2"
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
 )
 
-// Config structure handle all elements.
-// It also contain the BaseURL and Formats...
-type Config struct {
-	BaseURL  string             `yaml:"baseURL"`
-	Formats  map[string]format  `yaml:"formats"`
-	Elements map[string]Element `yaml:"elements"`
+//go:generate go run genconfigs.go
+
+// serviceConfigs give the config file of each service.
+var serviceConfigs = map[string]string{
+	"geofabrik":          "geofabrik.yml",
+	"geofabrik-internal": "geofabrik-internal.yml",
+	"openstreetmap.fr":   "openstreetmap.fr.yml",
+	"gislab":             "gislab.yml",
+}
+
+// Config comes from the library.
+type Config = geofabrik.Config
+
+// configClient make a library client which find shipped config files
+// in the cache or in the binary.
+func configClient() *geofabrik.Client {
+	client := geofabrik.NewClient()
+	client.ReadFile = readConfigFile
+	return client
 }
 
 // loadConfig loading configFile and send *Config.
+// Files in include are loaded first, then configFile is merged on top of them.
 // If there is an error, return it also.
 func loadConfig(configFile string) (*Config, error) {
+	return configClient().LoadConfig(configFile)
+}
+
+// readConfig read a single config file, without includes.
+func readConfig(configFile string) (*Config, error) {
+	return configClient().ReadConfig(configFile)
+}
+
+// readConfigFile read configFile.
+// Service config files are cached or embedded, use them if not found.
+// Some, like geofabrik-internal.yml, are not embedded and must be generated.
+func readConfigFile(configFile string) ([]byte, error) {
 	filename, _ := filepath.Abs(configFile)       // Get absolute path
 	fileContent, err := ioutil.ReadFile(filename) // Open file as string
-	if err != nil {
+	if err == nil {
+		return fileContent, nil
+	}
+	cached := cacheFile(configFile)
+	if cached == "" || !os.IsNotExist(err) {
 		return nil, err
 	}
-	// Create a Config ptr
-	myConfigPtr := new(Config)
-	// Charging fileContent into myConfigPtr
-	err = yaml.Unmarshal(fileContent, myConfigPtr)
+	if fileExist(cached) {
+		return ioutil.ReadFile(cached)
+	}
+	embedded, ok := embeddedConfigs[filepath.Clean(configFile)]
+	if !ok {
+		return nil, fmt.Errorf("%s is not shipped, please generate it with config refresh", configFile)
+	}
+	return []byte(embedded), nil
+}
+
+// convertConfig write inputFile into outputFile.
+// Formats are found with extensions.
+func convertConfig(inputFile string, outputFile string) error {
+	c, err := loadConfig(inputFile)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	out, err := geofabrik.MarshalConfig(c, geofabrik.ConfigFormat(outputFile, nil))
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outputFile, out, 0644)
+}
+
+// exportConfig write the embedded config of service in dir.
+// An existing file is only replaced if force is true.
+func exportConfig(dir string, service string, force bool) (string, error) {
+	name, ok := serviceConfigs[service]
+	if !ok {
+		return "", fmt.Errorf("unknown service %s", service)
+	}
+	embedded, ok := embeddedConfigs[name]
+	if !ok {
+		return "", fmt.Errorf("%s is not shipped, please generate it with config refresh", name)
+	}
+	filename := filepath.Join(dir, name)
+	if !force && fileExist(filename) {
+		return "", fmt.Errorf("%s already exist, use --force to replace it", filename)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
 	}
-	// Everything is OK, returning myConfigPtr
-	return myConfigPtr, nil
+	return filename, ioutil.WriteFile(filename, []byte(embedded), 0644)
 }
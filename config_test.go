@@ -1,7 +1,11 @@
 package main
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -89,3 +93,251 @@ func Test_loadConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_embeddedConfigs(t *testing.T) {
+	for name := range embeddedConfigs {
+		t.Run(name, func(t *testing.T) {
+			content, err := ioutil.ReadFile(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if embeddedConfigs[name] != string(content) {
+				t.Errorf("embedded %s is outdated, run go generate", name)
+			}
+		})
+	}
+}
+
+func Test_loadConfig_notShipped(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer os.Setenv("XDG_CACHE_HOME", os.Getenv("XDG_CACHE_HOME"))
+	os.Setenv("XDG_CACHE_HOME", dir)
+	pwd, _ := os.Getwd()
+	defer os.Chdir(pwd)
+	os.Chdir(dir)
+	if _, err := loadConfig("./geofabrik-internal.yml"); err == nil || !strings.Contains(err.Error(), "config refresh") {
+		t.Errorf("loadConfig() error = %v, want to generate it", err)
+	}
+	if _, err := exportConfig(dir, "geofabrik-internal", false); err == nil {
+		t.Errorf("exportConfig() of a config not shipped, want an error")
+	}
+	cached := filepath.Join(dir, "download-geofabrik", "geofabrik-internal.yml")
+	os.MkdirAll(filepath.Dir(cached), 0755)
+	ioutil.WriteFile(cached, []byte("baseURL: https://osm-internal.download.geofabrik.de\n"), 0644)
+	got, err := loadConfig("./geofabrik-internal.yml")
+	if err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+	if got.BaseURL != "https://osm-internal.download.geofabrik.de" {
+		t.Errorf("loadConfig().BaseURL = %v, want the cached config", got.BaseURL)
+	}
+}
+
+func Test_loadConfig_embedded(t *testing.T) {
+	want, err := loadConfig("./gislab.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	pwd, _ := os.Getwd()
+	defer os.Chdir(pwd)
+	os.Chdir(dir)
+	got, err := loadConfig("./gislab.yml")
+	if err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("loadConfig() = %v, want %v", got, want)
+	}
+	if _, err := loadConfig(filepath.Join(dir, "sub", "gislab.yml")); err == nil {
+		t.Errorf("loadConfig() use embedded config for a path")
+	}
+	ioutil.WriteFile("gislab.yml", []byte("baseURL: https://my.base.url\n"), 0644)
+	got, err = loadConfig("./gislab.yml")
+	if err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+	if got.BaseURL != "https://my.base.url" {
+		t.Errorf("loadConfig().BaseURL = %v, file on disk must be used first", got.BaseURL)
+	}
+}
+
+func Test_exportConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	existing := filepath.Join(dir, "exist")
+	os.MkdirAll(existing, 0755)
+	ioutil.WriteFile(filepath.Join(existing, "geofabrik.yml"), []byte("old"), 0644)
+	tests := []struct {
+		name    string
+		dir     string
+		service string
+		force   bool
+		want    string
+		wantErr bool
+	}{
+		{name: "geofabrik", dir: dir, service: "geofabrik", want: filepath.Join(dir, "geofabrik.yml")},
+		{name: "new dir", dir: filepath.Join(dir, "new"), service: "openstreetmap.fr", want: filepath.Join(dir, "new", "openstreetmap.fr.yml")},
+		{name: "unknown service", dir: dir, service: "anothermap", wantErr: true},
+		{name: "existing file", dir: existing, service: "geofabrik", wantErr: true},
+		{name: "existing file forced", dir: existing, service: "geofabrik", force: true, want: filepath.Join(existing, "geofabrik.yml")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := exportConfig(tt.dir, tt.service, tt.force)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("exportConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("exportConfig() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			content, _ := ioutil.ReadFile(got)
+			if string(content) != embeddedConfigs[serviceConfigs[tt.service]] {
+				t.Errorf("exportConfig() don't write embedded config in %v", got)
+			}
+		})
+	}
+}
+
+func Test_convertConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	want, err := loadConfig("./gislab.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := "./gislab.yml"
+	for _, ext := range []string{".json", ".toml", ".yml"} {
+		output := filepath.Join(dir, "gislab"+ext)
+		if err := convertConfig(input, output); err != nil {
+			t.Fatalf("convertConfig(%v, %v) error = %v", input, output, err)
+		}
+		got, err := loadConfig(output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("convertConfig(%v, %v) is not lossless", input, output)
+		}
+		input = output
+	}
+	if err := convertConfig("./this_file_not_exists", filepath.Join(dir, "out.json")); err == nil {
+		t.Errorf("convertConfig() must fail on missing file")
+	}
+}
+
+func Test_loadConfig_include(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"base.yml": `baseURL: https://my.base.url
+formats:
+  osm.pbf: {ext: osm.pbf, loc: -latest.osm.pbf}
+elements:
+  europe: {id: europe, name: Europe, files: [osm.pbf]}
+  france: {id: france, name: France, files: [osm.pbf], parent: europe}
+`,
+		"overlay.yml": `include: [base.yml]
+baseURL: https://ignored.url
+formats:
+  internal.pbf: {ext: internal.pbf, loc: .osm.pbf, baseurl: "https://internal.url"}
+elements:
+  company: {id: company, name: Company, files: [internal.pbf], parent: europe}
+  france: {id: france, name: France with company, files: [osm.pbf, internal.pbf], parent: europe}
+`,
+		"overlay.json": `{"include": ["overlay.yml"], "elements": {"company-hq": {"id": "company-hq", "files": ["internal.pbf"], "parent": "company"}}}`,
+		"conflict.yml": `include: [base.yml]
+elements:
+  france: {id: france, name: France, files: [osm.pbf]}
+`,
+		"loop.yml":    "include: [loop2.yml]\n",
+		"loop2.yml":   "include: [loop.yml]\n",
+		"missing.yml": "include: [this_file_not_exists.yml]\n",
+		"service.yml": "include: [gislab.yml]\n",
+	}
+	for name, content := range files {
+		ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+	}
+	tests := []struct {
+		name         string
+		configFile   string
+		wantBaseURL  string
+		wantElements map[string]string // id: name
+		wantFormats  []string
+		wantErr      bool
+	}{
+		{
+			name:         "overlay",
+			configFile:   filepath.Join(dir, "overlay.yml"),
+			wantBaseURL:  "https://my.base.url",
+			wantElements: map[string]string{"europe": "Europe", "france": "France with company", "company": "Company"},
+			wantFormats:  []string{"internal.pbf", "osm.pbf"},
+		},
+		{
+			name:         "overlay of overlay",
+			configFile:   filepath.Join(dir, "overlay.json"),
+			wantBaseURL:  "https://my.base.url",
+			wantElements: map[string]string{"europe": "Europe", "france": "France with company", "company": "Company", "company-hq": ""},
+			wantFormats:  []string{"internal.pbf", "osm.pbf"},
+		},
+		{
+			name:         "service config",
+			configFile:   filepath.Join(dir, "service.yml"),
+			wantBaseURL:  "http://be.gis-lab.info/project/osm_dump",
+			wantElements: map[string]string{"AM": "Армения"},
+			wantFormats:  []string{"osm.bz2", "osm.pbf", "poly"},
+		},
+		{name: "parent mismatch", configFile: filepath.Join(dir, "conflict.yml"), wantErr: true},
+		{name: "include loop", configFile: filepath.Join(dir, "loop.yml"), wantErr: true},
+		{name: "missing include", configFile: filepath.Join(dir, "missing.yml"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadConfig(tt.configFile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.BaseURL != tt.wantBaseURL {
+				t.Errorf("loadConfig().BaseURL = %v, want %v", got.BaseURL, tt.wantBaseURL)
+			}
+			if got.Include != nil {
+				t.Errorf("loadConfig().Include = %v, want nil", got.Include)
+			}
+			for id, name := range tt.wantElements {
+				if e, ok := got.Elements[id]; !ok || e.Name != name {
+					t.Errorf("loadConfig().Elements[%v] = %+v, want name %v", id, e, name)
+				}
+			}
+			if tt.name != "service config" && len(got.Elements) != len(tt.wantElements) {
+				t.Errorf("loadConfig() have %d elements, want %d", len(got.Elements), len(tt.wantElements))
+			}
+			if formats := formatKeys(got.Formats); !reflect.DeepEqual(formats, tt.wantFormats) {
+				t.Errorf("loadConfig().Formats = %v, want %v", formats, tt.wantFormats)
+			}
+		})
+	}
+}
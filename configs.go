@@ -0,0 +1,10957 @@
+// Code generated by go run genconfigs.go; DO NOT EDIT.
+
+package main
+
+// embeddedConfigs are config files shipped in the binary.
+// They are used when the file is not found.
+var embeddedConfigs = map[string]string{
+	"geofabrik.yml": `version: 1
+baseURL: https://download.geofabrik.de
+formats:
+  kml:
+    ext: kml
+    loc: .kml
+  osm.bz2:
+    ext: osm.bz2
+    loc: -latest.osm.bz2
+  osm.bz2.md5:
+    ext: osm.bz2.md5
+    loc: -latest.osm.bz2.md5
+  osm.pbf:
+    ext: osm.pbf
+    loc: -latest.osm.pbf
+  osm.pbf.md5:
+    ext: osm.pbf.md5
+    loc: -latest.osm.pbf.md5
+  poly:
+    ext: poly
+    loc: .poly
+  shp.zip:
+    ext: shp.zip
+    loc: -latest-free.shp.zip
+  state:
+    ext: state
+    loc: -updates/state.txt
+elements:
+  afghanistan:
+    id: afghanistan
+    name: Afghanistan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  africa:
+    id: africa
+    name: Africa
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+  alabama:
+    id: alabama
+    name: Alabama
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  alaska:
+    id: alaska
+    name: Alaska
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  albania:
+    id: albania
+    name: Albania
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  alberta:
+    id: alberta
+    name: Alberta
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  algeria:
+    id: algeria
+    name: Algeria
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  alps:
+    id: alps
+    name: Alps
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  alsace:
+    id: alsace
+    name: Alsace
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  andorra:
+    id: andorra
+    name: Andorra
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  angola:
+    id: angola
+    name: Angola
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  antarctica:
+    id: antarctica
+    name: Antarctica
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+  aquitaine:
+    id: aquitaine
+    name: Aquitaine
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  argentina:
+    id: argentina
+    name: Argentina
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: south-america
+  arizona:
+    id: arizona
+    name: Arizona
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  arkansas:
+    id: arkansas
+    name: Arkansas
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  armenia:
+    id: armenia
+    name: Armenia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  arnsberg-regbez:
+    id: arnsberg-regbez
+    name: Regierungsbezirk Arnsberg
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: nordrhein-westfalen
+  asia:
+    id: asia
+    name: Asia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+  australia:
+    id: australia
+    name: Australia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: australia-oceania
+  australia-oceania:
+    id: australia-oceania
+    name: Australia and Oceania
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+  austria:
+    id: austria
+    name: Austria
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  auvergne:
+    id: auvergne
+    name: Auvergne
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  azerbaijan:
+    id: azerbaijan
+    name: Azerbaijan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  azores:
+    id: azores
+    name: Azores
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  baden-wuerttemberg:
+    id: baden-wuerttemberg
+    name: Baden-Württemberg
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  bangladesh:
+    id: bangladesh
+    name: Bangladesh
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  basse-normandie:
+    id: basse-normandie
+    name: Basse-Normandie
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  bayern:
+    id: bayern
+    name: Bayern
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  bedfordshire:
+    id: bedfordshire
+    name: Bedfordshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  belarus:
+    id: belarus
+    name: Belarus
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  belgium:
+    id: belgium
+    name: Belgium
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  belize:
+    id: belize
+    name: Belize
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: central-america
+  benin:
+    id: benin
+    name: Benin
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  berkshire:
+    id: berkshire
+    name: Berkshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  berlin:
+    id: berlin
+    name: Berlin
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  bhutan:
+    id: bhutan
+    name: Bhutan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  bolivia:
+    id: bolivia
+    name: Bolivia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: south-america
+  bosnia-herzegovina:
+    id: bosnia-herzegovina
+    name: Bosnia-Herzegovina
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  botswana:
+    id: botswana
+    name: Botswana
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  bourgogne:
+    id: bourgogne
+    name: Bourgogne
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  brandenburg:
+    id: brandenburg
+    name: Brandenburg (mit Berlin)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  brazil:
+    id: brazil
+    name: Brazil
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: south-america
+  bremen:
+    id: bremen
+    name: Bremen
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  bretagne:
+    id: bretagne
+    name: Bretagne
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  bristol:
+    id: bristol
+    name: Bristol
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  british-columbia:
+    id: british-columbia
+    name: British Columbia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  british-isles:
+    id: british-isles
+    name: British Isles
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  buckinghamshire:
+    id: buckinghamshire
+    name: Buckinghamshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  bulgaria:
+    id: bulgaria
+    name: Bulgaria
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  burkina-faso:
+    id: burkina-faso
+    name: Burkina Faso
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  burundi:
+    id: burundi
+    name: Burundi
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  california:
+    id: california
+    name: california
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  cambodia:
+    id: cambodia
+    name: Cambodia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  cambridgeshire:
+    id: cambridgeshire
+    name: Cambridgeshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  cameroon:
+    id: cameroon
+    name: Cameroon
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  canada:
+    id: canada
+    name: Canada
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: north-america
+  canary-islands:
+    id: canary-islands
+    name: Canary Islands
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  cape-verde:
+    id: cape-verde
+    name: Cape Verde
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  central-african-republic:
+    id: central-african-republic
+    name: Central African Republic
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  central-america:
+    id: central-america
+    name: Central America
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+  central-fed-district:
+    id: central-fed-district
+    name: Central Federal District
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: russia
+  centre:
+    id: centre
+    name: Centre
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  centro:
+    id: centro
+    name: Centro
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: italy
+  chad:
+    id: chad
+    name: Chad
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  champagne-ardenne:
+    id: champagne-ardenne
+    name: Champagne Ardenne
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  cheshire:
+    id: cheshire
+    name: Cheshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  chile:
+    id: chile
+    name: Chile
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: south-america
+  china:
+    id: china
+    name: China
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  chubu:
+    id: chubu
+    name: Chūbu region
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: japan
+  chugoku:
+    id: chugoku
+    name: Chūgoku region
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: japan
+  colombia:
+    id: colombia
+    name: Colombia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: south-america
+  colorado:
+    id: colorado
+    name: Colorado
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  comores:
+    id: comores
+    name: Comores
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  congo-brazzaville:
+    id: congo-brazzaville
+    name: Congo (Republic/Brazzaville)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  congo-democratic-republic:
+    id: congo-democratic-republic
+    name: Congo (Democratic Republic/Kinshasa)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  connecticut:
+    id: connecticut
+    name: Connecticut
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  cornwall:
+    id: cornwall
+    name: Cornwall
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  corse:
+    id: corse
+    name: Corse
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  crimean-fed-district:
+    id: crimean-fed-district
+    name: Crimean Federal District
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: russia
+  croatia:
+    id: croatia
+    name: Croatia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  cuba:
+    id: cuba
+    name: Cuba
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: central-america
+  cumbria:
+    id: cumbria
+    name: Cumbria
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  cyprus:
+    id: cyprus
+    name: Cyprus
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  czech-republic:
+    id: czech-republic
+    name: Czech Republic
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  dach:
+    id: dach
+    name: Germany, Austria, Switzerland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  delaware:
+    id: delaware
+    name: Delaware
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  denmark:
+    id: denmark
+    name: Denmark
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  derbyshire:
+    id: derbyshire
+    name: Derbyshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  detmold-regbez:
+    id: detmold-regbez
+    name: Regierungsbezirk Detmold
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: nordrhein-westfalen
+  devon:
+    id: devon
+    name: Devon
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  district-of-columbia:
+    id: district-of-columbia
+    name: District of Columbia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  djibouti:
+    id: djibouti
+    name: Djibouti
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  dolnoslaskie:
+    id: dolnoslaskie
+    name: Województwo dolnośląskie(Lower Silesian Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  dorset:
+    id: dorset
+    name: Dorset
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  drenthe:
+    id: drenthe
+    name: Drenthe
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+  duesseldorf-regbez:
+    id: duesseldorf-regbez
+    name: Regierungsbezirk Düsseldorf
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: nordrhein-westfalen
+  durham:
+    id: durham
+    name: Durham
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  east-sussex:
+    id: east-sussex
+    name: East Sussex
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  east-yorkshire-with-hull:
+    id: east-yorkshire-with-hull
+    name: East Yorkshire with Hull
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  ecuador:
+    id: ecuador
+    name: Ecuador
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: south-america
+  egypt:
+    id: egypt
+    name: Egypt
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  england:
+    id: england
+    name: England
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: great-britain
+  equatorial-guinea:
+    id: equatorial-guinea
+    name: Equatorial Guinea
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  eritrea:
+    id: eritrea
+    name: Eritrea
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  essex:
+    id: essex
+    name: Essex
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  estonia:
+    id: estonia
+    name: Estonia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  ethiopia:
+    id: ethiopia
+    name: Ethiopia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  europe:
+    id: europe
+    name: Europe
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+  far-eastern-fed-district:
+    id: far-eastern-fed-district
+    name: Far Eastern Federal District
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: russia
+  faroe-islands:
+    id: faroe-islands
+    name: Faroe Islands
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  fiji:
+    id: fiji
+    name: Fiji
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: australia-oceania
+  finland:
+    id: finland
+    name: Finland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  flevoland:
+    id: flevoland
+    name: Flevoland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+  florida:
+    id: florida
+    name: Florida
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  france:
+    id: france
+    name: France
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  franche-comte:
+    id: franche-comte
+    name: Franche Comte
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  freiburg-regbez:
+    id: freiburg-regbez
+    name: Regierungsbezirk Freiburg
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: baden-wuerttemberg
+  friesland:
+    id: friesland
+    name: Friesland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+  gabon:
+    id: gabon
+    name: Gabon
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  gcc-states:
+    id: gcc-states
+    name: GCC States
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  gelderland:
+    id: gelderland
+    name: Gelderland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+  georgia-eu:
+    id: georgia-eu
+    file: georgia
+    name: Georgia (Europe country)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  georgia-us:
+    id: georgia-us
+    file: georgia
+    name: Georgia (US State)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  germany:
+    id: germany
+    name: Germany
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  ghana:
+    id: ghana
+    name: Ghana
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  gloucestershire:
+    id: gloucestershire
+    name: Gloucestershire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  great-britain:
+    id: great-britain
+    name: Great Britain
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  greater-london:
+    id: greater-london
+    name: Greater London
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  greater-manchester:
+    id: greater-manchester
+    name: Greater Manchester
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  greece:
+    id: greece
+    name: Greece
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  greenland:
+    id: greenland
+    name: Greenland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: north-america
+  groningen:
+    id: groningen
+    name: Groningen
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+  guadeloupe:
+    id: guadeloupe
+    name: Guadeloupe
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  guatemala:
+    id: guatemala
+    name: Guatemala
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: central-america
+  guinea:
+    id: guinea
+    name: Guinea
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  guinea-bissau:
+    id: guinea-bissau
+    name: Guinea-Bissau
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  guyane:
+    id: guyane
+    name: Guyane
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  haiti-and-domrep:
+    id: haiti-and-domrep
+    name: Haiti and Dominican Republic
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: central-america
+  hamburg:
+    id: hamburg
+    name: Hamburg
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  hampshire:
+    id: hampshire
+    name: Hampshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  haute-normandie:
+    id: haute-normandie
+    name: Haute-Normandie
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  hawaii:
+    id: hawaii
+    name: Hawaii
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  herefordshire:
+    id: herefordshire
+    name: Herefordshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  hertfordshire:
+    id: hertfordshire
+    name: Hertfordshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  hessen:
+    id: hessen
+    name: Hessen
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  hokkaido:
+    id: hokkaido
+    name: Hokkaidō
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: japan
+  hungary:
+    id: hungary
+    name: Hungary
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  iceland:
+    id: iceland
+    name: Iceland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  idaho:
+    id: idaho
+    name: Idaho
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  ile-de-france:
+    id: ile-de-france
+    name: Ile-de-France
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  illinois:
+    id: illinois
+    name: Illinois
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  india:
+    id: india
+    name: India
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  indiana:
+    id: indiana
+    name: Indiana
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  indonesia:
+    id: indonesia
+    name: Indonesia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  iowa:
+    id: iowa
+    name: Iowa
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  iran:
+    id: iran
+    name: Iran
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  iraq:
+    id: iraq
+    name: Iraq
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  ireland-and-northern-ireland:
+    id: ireland-and-northern-ireland
+    name: Ireland and Northern Ireland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  isle-of-man:
+    id: isle-of-man
+    name: Isle of Man
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  isle-of-wight:
+    id: isle-of-wight
+    name: Isle of Wight
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  isole:
+    id: isole
+    name: Isole
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: italy
+  israel-and-palestine:
+    id: israel-and-palestine
+    name: Israel and Palestine
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  italy:
+    id: italy
+    name: Italy
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  ivory-coast:
+    id: ivory-coast
+    name: Ivory Coast
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  jamaica:
+    id: jamaica
+    name: Jamaica
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: central-america
+  japan:
+    id: japan
+    name: Japan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  jordan:
+    id: jordan
+    name: Jordan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  kaliningrad:
+    id: kaliningrad
+    name: Kaliningrad
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: russia
+  kansai:
+    id: kansai
+    name: Kansai region (a.k.a. Kinki region)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: japan
+  kansas:
+    id: kansas
+    name: Kansas
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  kanto:
+    id: kanto
+    name: Kantō region
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: japan
+  karlsruhe-regbez:
+    id: karlsruhe-regbez
+    name: Regierungsbezirk Karlsruhe
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: baden-wuerttemberg
+  kazakhstan:
+    id: kazakhstan
+    name: Kazakhstan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  kent:
+    id: kent
+    name: Kent
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  kentucky:
+    id: kentucky
+    name: Kentucky
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  kenya:
+    id: kenya
+    name: Kenya
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  koeln-regbez:
+    id: koeln-regbez
+    name: Regierungsbezirk Köln
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: nordrhein-westfalen
+  kosovo:
+    id: kosovo
+    name: Kosovo
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  kujawsko-pomorskie:
+    id: kujawsko-pomorskie
+    name: Województwo kujawsko-pomorskie(Kuyavian-Pomeranian Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  kyrgyzstan:
+    id: kyrgyzstan
+    name: Kyrgyzstan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  kyushu:
+    id: kyushu
+    name: Kyūshū
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: japan
+  lancashire:
+    id: lancashire
+    name: Lancashire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  languedoc-roussillon:
+    id: languedoc-roussillon
+    name: Languedoc-Roussillon
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  laos:
+    id: laos
+    name: Laos
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  latvia:
+    id: latvia
+    name: Latvia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  lebanon:
+    id: lebanon
+    name: Lebanon
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  leicestershire:
+    id: leicestershire
+    name: Leicestershire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  lesotho:
+    id: lesotho
+    name: Lesotho
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  liberia:
+    id: liberia
+    name: Liberia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  libya:
+    id: libya
+    name: Libya
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  liechtenstein:
+    id: liechtenstein
+    name: Liechtenstein
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  limburg:
+    id: limburg
+    name: Limburg
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+  limousin:
+    id: limousin
+    name: Limousin
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  lincolnshire:
+    id: lincolnshire
+    name: Lincolnshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  lithuania:
+    id: lithuania
+    name: Lithuania
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  lodzkie:
+    id: lodzkie
+    name: Województwo łódzkie(Łódź Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  lorraine:
+    id: lorraine
+    name: Lorraine
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  louisiana:
+    id: louisiana
+    name: Louisiana
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  lubelskie:
+    id: lubelskie
+    name: Województwo lubelskie(Lublin Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  lubuskie:
+    id: lubuskie
+    name: Województwo lubuskie(Lubusz Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  luxembourg:
+    id: luxembourg
+    name: Luxembourg
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  macedonia:
+    id: macedonia
+    name: Macedonia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  madagascar:
+    id: madagascar
+    name: Madagascar
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  maine:
+    id: maine
+    name: Maine
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  malawi:
+    id: malawi
+    name: Malawi
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  malaysia-singapore-brunei:
+    id: malaysia-singapore-brunei
+    name: Malaysia, Singapore, and Brunei
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  maldives:
+    id: maldives
+    name: Maldives
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  mali:
+    id: mali
+    name: Mali
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  malopolskie:
+    id: malopolskie
+    name: Województwo małopolskie(Lesser Poland Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  malta:
+    id: malta
+    name: Malta
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  manitoba:
+    id: manitoba
+    name: Manitoba
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  martinique:
+    id: martinique
+    name: Martinique
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  maryland:
+    id: maryland
+    name: Maryland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  massachusetts:
+    id: massachusetts
+    name: Massachusetts
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  mauritania:
+    id: mauritania
+    name: Mauritania
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  mauritius:
+    id: mauritius
+    name: Mauritius
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  mayotte:
+    id: mayotte
+    name: Mayotte
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  mazowieckie:
+    id: mazowieckie
+    name: Województwo mazowieckie(Mazovian Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  mecklenburg-vorpommern:
+    id: mecklenburg-vorpommern
+    name: Mecklenburg-Vorpommern
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  merseyside:
+    id: merseyside
+    name: Merseyside
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  mexico:
+    id: mexico
+    name: Mexico
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: north-america
+  michigan:
+    id: michigan
+    name: Michigan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  midi-pyrenees:
+    id: midi-pyrenees
+    name: Midi-Pyrenees
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  minnesota:
+    id: minnesota
+    name: Minnesota
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  mississippi:
+    id: mississippi
+    name: Mississippi
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  missouri:
+    id: missouri
+    name: Missouri
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  mittelfranken:
+    id: mittelfranken
+    name: Mittelfranken
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: bayern
+  moldova:
+    id: moldova
+    name: Moldova
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  monaco:
+    id: monaco
+    name: Monaco
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  mongolia:
+    id: mongolia
+    name: Mongolia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  montana:
+    id: montana
+    name: Montana
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  montenegro:
+    id: montenegro
+    name: Montenegro
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  morocco:
+    id: morocco
+    name: Morocco
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  mozambique:
+    id: mozambique
+    name: Mozambique
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  muenster-regbez:
+    id: muenster-regbez
+    name: Regierungsbezirk Münster
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: nordrhein-westfalen
+  myanmar:
+    id: myanmar
+    name: Myanmar (a.k.a. Burma)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  namibia:
+    id: namibia
+    name: Namibia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  nebraska:
+    id: nebraska
+    name: Nebraska
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  nepal:
+    id: nepal
+    name: Nepal
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  netherlands:
+    id: netherlands
+    name: Netherlands
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  nevada:
+    id: nevada
+    name: Nevada
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  new-brunswick:
+    id: new-brunswick
+    name: New Brunswick
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  new-caledonia:
+    id: new-caledonia
+    name: New Caledonia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: australia-oceania
+  new-hampshire:
+    id: new-hampshire
+    name: New Hampshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  new-jersey:
+    id: new-jersey
+    name: New Jersey
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  new-mexico:
+    id: new-mexico
+    name: New Mexico
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  new-york:
+    id: new-york
+    name: New York
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  new-zealand:
+    id: new-zealand
+    name: New Zealand
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: australia-oceania
+  newfoundland-and-labrador:
+    id: newfoundland-and-labrador
+    name: Newfoundland and Labrador
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  nicaragua:
+    id: nicaragua
+    name: Nicaragua
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: central-america
+  niederbayern:
+    id: niederbayern
+    name: Niederbayern
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: bayern
+  niedersachsen:
+    id: niedersachsen
+    name: Niedersachsen
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  niger:
+    id: niger
+    name: Niger
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  nigeria:
+    id: nigeria
+    name: Nigeria
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  noord-brabant:
+    id: noord-brabant
+    name: Noord-Brabant
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+  noord-holland:
+    id: noord-holland
+    name: Noord-Holland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+  norcal:
+    id: norcal
+    name: Northern California
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: california
+  nord-est:
+    id: nord-est
+    name: Nord-Est
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: italy
+  nord-ovest:
+    id: nord-ovest
+    name: Nord-Ovest
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: italy
+  nord-pas-de-calais:
+    id: nord-pas-de-calais
+    name: Nord-Pas-de-Calais
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  nordrhein-westfalen:
+    id: nordrhein-westfalen
+    name: Nordrhein-Westfalen
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  norfolk:
+    id: norfolk
+    name: Norfolk
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  north-america:
+    id: north-america
+    name: North America
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+  north-carolina:
+    id: north-carolina
+    name: North Carolina
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  north-caucasus-fed-district:
+    id: north-caucasus-fed-district
+    name: North Caucasus Federal District
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: russia
+  north-dakota:
+    id: north-dakota
+    name: North Dakota
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  north-korea:
+    id: north-korea
+    name: North Korea
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  north-yorkshire:
+    id: north-yorkshire
+    name: North Yorkshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  northamptonshire:
+    id: northamptonshire
+    name: Northamptonshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  northumberland:
+    id: northumberland
+    name: Northumberland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  northwest-territories:
+    id: northwest-territories
+    name: Northwest Territories
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  northwestern-fed-district:
+    id: northwestern-fed-district
+    name: Northwestern Federal District
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: russia
+  norway:
+    id: norway
+    name: Norway
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  nottinghamshire:
+    id: nottinghamshire
+    name: Nottinghamshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  nova-scotia:
+    id: nova-scotia
+    name: Nova Scotia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  nunavut:
+    id: nunavut
+    name: Nunavut
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  oberbayern:
+    id: oberbayern
+    name: Oberbayern
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: bayern
+  oberfranken:
+    id: oberfranken
+    name: Oberfranken
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: bayern
+  oberpfalz:
+    id: oberpfalz
+    name: Oberpfalz
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: bayern
+  ohio:
+    id: ohio
+    name: Ohio
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  oklahoma:
+    id: oklahoma
+    name: Oklahoma
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  ontario:
+    id: ontario
+    name: Ontario
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  opolskie:
+    id: opolskie
+    name: Województwo opolskie(Opole Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  oregon:
+    id: oregon
+    name: Oregon
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  overijssel:
+    id: overijssel
+    name: Overijssel
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+  oxfordshire:
+    id: oxfordshire
+    name: Oxfordshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  pakistan:
+    id: pakistan
+    name: Pakistan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  papua-new-guinea:
+    id: papua-new-guinea
+    name: Papua New Guinea
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: australia-oceania
+  paraguay:
+    id: paraguay
+    name: Paraguay
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: south-america
+  pays-de-la-loire:
+    id: pays-de-la-loire
+    name: Pays de la Loire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  pennsylvania:
+    id: pennsylvania
+    name: Pennsylvania
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  peru:
+    id: peru
+    name: Peru
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: south-america
+  philippines:
+    id: philippines
+    name: Philippines
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  picardie:
+    id: picardie
+    name: Picardie
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  podkarpackie:
+    id: podkarpackie
+    name: Województwo podkarpackie(Subcarpathian Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  podlaskie:
+    id: podlaskie
+    name: Województwo podlaskie(Podlaskie Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  poitou-charentes:
+    id: poitou-charentes
+    name: Poitou-Charentes
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  poland:
+    id: poland
+    name: Poland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  pomorskie:
+    id: pomorskie
+    name: Województwo pomorskie(Pomeranian Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  portugal:
+    id: portugal
+    name: Portugal
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  prince-edward-island:
+    id: prince-edward-island
+    name: Prince Edward Island
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  provence-alpes-cote-d-azur:
+    id: provence-alpes-cote-d-azur
+    name: Provence Alpes-Cote-d'Azur
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  puerto-rico:
+    id: puerto-rico
+    name: Puerto Rico
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  quebec:
+    id: quebec
+    name: Quebec
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  reunion:
+    id: reunion
+    name: Reunion
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  rheinland-pfalz:
+    id: rheinland-pfalz
+    name: Rheinland-Pfalz
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  rhode-island:
+    id: rhode-island
+    name: Rhode Island
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  rhone-alpes:
+    id: rhone-alpes
+    name: Rhone-Alpes
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: france
+  romania:
+    id: romania
+    name: Romania
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  russia:
+    id: russia
+    name: Russian Federation
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+  rutland:
+    id: rutland
+    name: Rutland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  rwanda:
+    id: rwanda
+    name: Rwanda
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  saarland:
+    id: saarland
+    name: Saarland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  sachsen:
+    id: sachsen
+    name: Sachsen
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  sachsen-anhalt:
+    id: sachsen-anhalt
+    name: Sachsen-Anhalt
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  saint-helena-ascension-and-tristan-da-cunha:
+    id: saint-helena-ascension-and-tristan-da-cunha
+    name: Saint Helena, Ascension, and Tristan da Cunha
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  sao-tome-and-principe:
+    id: sao-tome-and-principe
+    name: Sao Tome and Principe
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  saskatchewan:
+    id: saskatchewan
+    name: Saskatchewan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  schleswig-holstein:
+    id: schleswig-holstein
+    name: Schleswig-Holstein
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  schwaben:
+    id: schwaben
+    name: Schwaben
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: bayern
+  scotland:
+    id: scotland
+    name: Scotland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: great-britain
+  senegal-and-gambia:
+    id: senegal-and-gambia
+    name: Senegal and Gambia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  serbia:
+    id: serbia
+    name: Serbia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  seychelles:
+    id: seychelles
+    name: Seychelles
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  shikoku:
+    id: shikoku
+    name: Shikoku
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: japan
+  shropshire:
+    id: shropshire
+    name: Shropshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  siberian-fed-district:
+    id: siberian-fed-district
+    name: Siberian Federal District
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: russia
+  sierra-leone:
+    id: sierra-leone
+    name: Sierra Leone
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  slaskie:
+    id: slaskie
+    name: Województwo śląskie(Silesian Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  slovakia:
+    id: slovakia
+    name: Slovakia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  slovenia:
+    id: slovenia
+    name: Slovenia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  socal:
+    id: socal
+    name: Southern California
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: california
+  somalia:
+    id: somalia
+    name: Somalia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  somerset:
+    id: somerset
+    name: Somerset
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  south-africa:
+    id: south-africa
+    name: South Africa
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  south-africa-and-lesotho:
+    id: south-africa-and-lesotho
+    name: South Africa (includes Lesotho)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  south-america:
+    id: south-america
+    name: South America
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+  south-carolina:
+    id: south-carolina
+    name: South Carolina
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  south-dakota:
+    id: south-dakota
+    name: South Dakota
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  south-fed-district:
+    id: south-fed-district
+    name: South Federal District
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: russia
+  south-korea:
+    id: south-korea
+    name: South Korea
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  south-sudan:
+    id: south-sudan
+    name: South Sudan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  south-yorkshire:
+    id: south-yorkshire
+    name: South Yorkshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  spain:
+    id: spain
+    name: Spain
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  sri-lanka:
+    id: sri-lanka
+    name: Sri Lanka
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  staffordshire:
+    id: staffordshire
+    name: Staffordshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  stuttgart-regbez:
+    id: stuttgart-regbez
+    name: Regierungsbezirk Stuttgart
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: baden-wuerttemberg
+  sud:
+    id: sud
+    name: Sud
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: italy
+  sudan:
+    id: sudan
+    name: Sudan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  suffolk:
+    id: suffolk
+    name: Suffolk
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  suriname:
+    id: suriname
+    name: Suriname
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: south-america
+  surrey:
+    id: surrey
+    name: Surrey
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  swaziland:
+    id: swaziland
+    name: Swaziland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  sweden:
+    id: sweden
+    name: Sweden
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  swietokrzyskie:
+    id: swietokrzyskie
+    name: Województwo świętokrzyskie(Świętokrzyskie Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  switzerland:
+    id: switzerland
+    name: Switzerland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  syria:
+    id: syria
+    name: Syria
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  taiwan:
+    id: taiwan
+    name: Taiwan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  tajikistan:
+    id: tajikistan
+    name: Tajikistan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  tanzania:
+    id: tanzania
+    name: Tanzania
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  tennessee:
+    id: tennessee
+    name: Tennessee
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  texas:
+    id: texas
+    name: Texas
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  thailand:
+    id: thailand
+    name: Thailand
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  thueringen:
+    id: thueringen
+    name: Thüringen
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: germany
+  togo:
+    id: togo
+    name: Togo
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  tohoku:
+    id: tohoku
+    name: Tōhoku region
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: japan
+  tuebingen-regbez:
+    id: tuebingen-regbez
+    name: Regierungsbezirk Tübingen
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: baden-wuerttemberg
+  tunisia:
+    id: tunisia
+    name: Tunisia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  turkey:
+    id: turkey
+    name: Turkey
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  turkmenistan:
+    id: turkmenistan
+    name: Turkmenistan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  tyne-and-wear:
+    id: tyne-and-wear
+    name: Tyne and Wear
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  uganda:
+    id: uganda
+    name: Uganda
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  ukraine:
+    id: ukraine
+    name: Ukraine (with Crimea)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: europe
+  unterfranken:
+    id: unterfranken
+    name: Unterfranken
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: bayern
+  ural-fed-district:
+    id: ural-fed-district
+    name: Ural Federal District
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: russia
+  uruguay:
+    id: uruguay
+    name: Uruguay
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: south-america
+  us:
+    id: us
+    meta: true
+    name: United States of America
+    parent: north-america
+  us-midwest:
+    id: us-midwest
+    name: US Midwest
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: north-america
+  us-northeast:
+    id: us-northeast
+    name: US Northeast
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: north-america
+  us-pacific:
+    id: us-pacific
+    name: US Pacific
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: north-america
+  us-south:
+    id: us-south
+    name: US South
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: north-america
+  us-west:
+    id: us-west
+    name: US West
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: north-america
+  utah:
+    id: utah
+    name: Utah
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  utrecht:
+    id: utrecht
+    name: Utrecht
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+  uzbekistan:
+    id: uzbekistan
+    name: Uzbekistan
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  venezuela:
+    id: venezuela
+    name: Venezuela
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: south-america
+  vermont:
+    id: vermont
+    name: Vermont
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  vietnam:
+    id: vietnam
+    name: Vietnam
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  virginia:
+    id: virginia
+    name: Virginia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  volga-fed-district:
+    id: volga-fed-district
+    name: Volga Federal District
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: russia
+  wales:
+    id: wales
+    name: Wales
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: great-britain
+  warminsko-mazurskie:
+    id: warminsko-mazurskie
+    name: Województwo warmińsko-mazurskie(Warmian-Masurian Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  warwickshire:
+    id: warwickshire
+    name: Warwickshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  washington:
+    id: washington
+    name: Washington
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  west-midlands:
+    id: west-midlands
+    name: West Midlands
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  west-sussex:
+    id: west-sussex
+    name: West Sussex
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  west-virginia:
+    id: west-virginia
+    name: West Virginia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  west-yorkshire:
+    id: west-yorkshire
+    name: West Yorkshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  wielkopolskie:
+    id: wielkopolskie
+    name: Województwo wielkopolskie(Greater Poland Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  wiltshire:
+    id: wiltshire
+    name: Wiltshire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  wisconsin:
+    id: wisconsin
+    name: Wisconsin
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  worcestershire:
+    id: worcestershire
+    name: Worcestershire
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: england
+  wyoming:
+    id: wyoming
+    name: Wyoming
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: us
+  yemen:
+    id: yemen
+    name: Yemen
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: asia
+  yukon:
+    id: yukon
+    name: Yukon
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: canada
+  zachodniopomorskie:
+    id: zachodniopomorskie
+    name: Województwo zachodniopomorskie(West Pomeranian Voivodeship)
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: poland
+  zambia:
+    id: zambia
+    name: Zambia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  zeeland:
+    id: zeeland
+    name: Zeeland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+  zimbabwe:
+    id: zimbabwe
+    name: Zimbabwe
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: africa
+  zuid-holland:
+    id: zuid-holland
+    name: Zuid-Holland
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - shp.zip
+    - osm.bz2
+    - osm.bz2.md5
+    - poly
+    - kml
+    - state
+    parent: netherlands
+`,
+	"openstreetmap.fr.yml": `version: 1
+baseURL: https://download.openstreetmap.fr/extracts
+formats:
+  osm.pbf:
+    ext: osm.pbf
+    loc: -latest.osm.pbf
+  poly:
+    ext: poly
+    loc: .poly
+    basepath: ../polygons/
+  state:
+    ext: state
+    loc: .state.txt
+elements:
+  abruzzo:
+    id: abruzzo
+    name: abruzzo
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  aceh:
+    id: aceh
+    name: aceh
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  acre:
+    id: acre
+    name: acre
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north
+  adygea_republic:
+    id: adygea_republic
+    name: adygea_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: southern_federal_district
+  afghanistan:
+    id: afghanistan
+    name: afghanistan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  africa:
+    id: africa
+    name: africa
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - state
+    - poly
+  alagoas:
+    id: alagoas
+    name: alagoas
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northeast
+  alameda:
+    id: alameda
+    name: alameda
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  algeria:
+    id: algeria
+    name: algeria
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  alpine:
+    id: alpine
+    name: alpine
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  altai_krai:
+    id: altai_krai
+    name: altai_krai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  altai_republic:
+    id: altai_republic
+    name: altai_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  amador:
+    id: amador
+    name: amador
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  amapa:
+    id: amapa
+    name: amapa
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north
+  amazonas:
+    id: amazonas
+    name: amazonas
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north
+  american_samoa:
+    id: american_samoa
+    name: american_samoa
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  amur_oblast:
+    id: amur_oblast
+    name: amur_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: far_eastern_federal_district
+  andalucia:
+    id: andalucia
+    name: andalucia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  andaman_and_nicobar_islands:
+    id: andaman_and_nicobar_islands
+    name: andaman_and_nicobar_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  andhra_pradesh:
+    id: andhra_pradesh
+    name: andhra_pradesh
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  angola:
+    id: angola
+    name: angola
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  anguilla:
+    id: anguilla
+    name: anguilla
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  anhui:
+    id: anhui
+    name: anhui
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  antigua_and_barbuda:
+    id: antigua_and_barbuda
+    name: antigua_and_barbuda
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  aragon:
+    id: aragon
+    name: aragon
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  arkhangelsk_oblast:
+    id: arkhangelsk_oblast
+    name: arkhangelsk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northwestern_federal_district
+  armenia:
+    id: armenia
+    name: armenia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  arnsberg:
+    id: arnsberg
+    name: arnsberg
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: nordrhein_westfalen
+  aruba:
+    id: aruba
+    name: aruba
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  arunachal_pradesh:
+    id: arunachal_pradesh
+    name: arunachal_pradesh
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  asia:
+    id: asia
+    name: asia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - state
+    - poly
+  assam:
+    id: assam
+    name: assam
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  astrakhan_oblast:
+    id: astrakhan_oblast
+    name: astrakhan_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: southern_federal_district
+  asturias:
+    id: asturias
+    name: asturias
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  australia:
+    id: australia
+    name: australia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  australian_capital_territory:
+    id: australian_capital_territory
+    name: australian_capital_territory
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  austria:
+    id: austria
+    name: austria
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  bahamas:
+    id: bahamas
+    name: bahamas
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  bahia:
+    id: bahia
+    name: bahia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northeast
+  bahrain:
+    id: bahrain
+    name: bahrain
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  bali:
+    id: bali
+    name: bali
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  bangka_belitung_islands:
+    id: bangka_belitung_islands
+    name: bangka_belitung_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  banskobystricky:
+    id: banskobystricky
+    name: banskobystricky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: slovakia
+  banten:
+    id: banten
+    name: banten
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  barbados:
+    id: barbados
+    name: barbados
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  bashkortostan_republic:
+    id: bashkortostan_republic
+    name: bashkortostan_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  basilicata:
+    id: basilicata
+    name: basilicata
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  beijing:
+    id: beijing
+    name: beijing
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  belgium:
+    id: belgium
+    name: belgium
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  belgorod_oblast:
+    id: belgorod_oblast
+    name: belgorod_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  bengkulu:
+    id: bengkulu
+    name: bengkulu
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  benin:
+    id: benin
+    name: benin
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  bermuda:
+    id: bermuda
+    name: bermuda
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north-america
+  bhutan:
+    id: bhutan
+    name: bhutan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  bihar:
+    id: bihar
+    name: bihar
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  bouvet_island:
+    id: bouvet_island
+    name: bouvet_island
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  bratislavsky:
+    id: bratislavsky
+    name: bratislavsky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: slovakia
+  brazil:
+    id: brazil
+    name: brazil
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  british_indian_ocean_territory:
+    id: british_indian_ocean_territory
+    name: british_indian_ocean_territory
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  british_virgin_islands:
+    id: british_virgin_islands
+    name: british_virgin_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  brunei:
+    id: brunei
+    name: brunei
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  brussels_capital_region:
+    id: brussels_capital_region
+    name: brussels_capital_region
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: belgium
+  bryansk_oblast:
+    id: bryansk_oblast
+    name: bryansk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  burgenland:
+    id: burgenland
+    name: burgenland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: austria
+  burkina_faso:
+    id: burkina_faso
+    name: burkina_faso
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  burundi:
+    id: burundi
+    name: burundi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  buryatia_republic:
+    id: buryatia_republic
+    name: buryatia_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  butte:
+    id: butte
+    name: butte
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  calabria:
+    id: calabria
+    name: calabria
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  calaveras:
+    id: calaveras
+    name: calaveras
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  california:
+    id: california
+    name: california
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: us-west
+  cambodia:
+    id: cambodia
+    name: cambodia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  cameroon:
+    id: cameroon
+    name: cameroon
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  campania:
+    id: campania
+    name: campania
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  canada:
+    id: canada
+    name: canada
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north-america
+  canarias:
+    id: canarias
+    name: canarias
+    files:
+    - osm.pbf
+    - state
+    - osm.pbf
+    - state
+    - poly
+    - poly
+    parent: spain
+  cantabria:
+    id: cantabria
+    name: cantabria
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  cape_verde:
+    id: cape_verde
+    name: cape_verde
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  caribbean:
+    id: caribbean
+    name: caribbean
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  castilla_la_mancha:
+    id: castilla_la_mancha
+    name: castilla_la_mancha
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  castilla_y_leon:
+    id: castilla_y_leon
+    name: castilla_y_leon
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  catalunya:
+    id: catalunya
+    name: catalunya
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  cayman_islands:
+    id: cayman_islands
+    name: cayman_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  ceara:
+    id: ceara
+    name: ceara
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northeast
+  central-america:
+    id: central-america
+    name: central-america
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - state
+    - poly
+  central-west:
+    id: central-west
+    name: central-west
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: brazil
+  central_african_republic:
+    id: central_african_republic
+    name: central_african_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  central_federal_district:
+    id: central_federal_district
+    name: central_federal_district
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: russia
+  central_java:
+    id: central_java
+    name: central_java
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  central_kalimantan:
+    id: central_kalimantan
+    name: central_kalimantan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  central_sulawesi:
+    id: central_sulawesi
+    name: central_sulawesi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  ceuta:
+    id: ceuta
+    name: ceuta
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  chad:
+    id: chad
+    name: chad
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  chandigarh:
+    id: chandigarh
+    name: chandigarh
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  chechen_republic:
+    id: chechen_republic
+    name: chechen_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north_caucasian_federal_district
+  chelyabinsk_oblast:
+    id: chelyabinsk_oblast
+    name: chelyabinsk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: ural_federal_district
+  chhattisgarh:
+    id: chhattisgarh
+    name: chhattisgarh
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  china:
+    id: china
+    name: china
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  chongqing:
+    id: chongqing
+    name: chongqing
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  christmas_island:
+    id: christmas_island
+    name: christmas_island
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  chubu:
+    id: chubu
+    name: chubu
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: japan
+  chugoku:
+    id: chugoku
+    name: chugoku
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: japan
+  chukotka_autonomous_okrug:
+    id: chukotka_autonomous_okrug
+    name: chukotka_autonomous_okrug
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: far_eastern_federal_district
+  chuvash_republic:
+    id: chuvash_republic
+    name: chuvash_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  cocos_islands:
+    id: cocos_islands
+    name: cocos_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  colusa:
+    id: colusa
+    name: colusa
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  comoros:
+    id: comoros
+    name: comoros
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  comunidad_de_madrid:
+    id: comunidad_de_madrid
+    name: comunidad_de_madrid
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  comunidad_foral_de_navarra:
+    id: comunidad_foral_de_navarra
+    name: comunidad_foral_de_navarra
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  comunitat_valenciana:
+    id: comunitat_valenciana
+    name: comunitat_valenciana
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  congo_brazzaville:
+    id: congo_brazzaville
+    name: congo_brazzaville
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  congo_kinshasa:
+    id: congo_kinshasa
+    name: congo_kinshasa
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  contra_costa:
+    id: contra_costa
+    name: contra_costa
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  cook_islands:
+    id: cook_islands
+    name: cook_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  coral_sea_islands:
+    id: coral_sea_islands
+    name: coral_sea_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  costa_rica:
+    id: costa_rica
+    name: costa_rica
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  curacao:
+    id: curacao
+    name: curacao
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  czech_republic:
+    id: czech_republic
+    name: czech_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  dadra_and_nagar_haveli:
+    id: dadra_and_nagar_haveli
+    name: dadra_and_nagar_haveli
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  dagestan_republic:
+    id: dagestan_republic
+    name: dagestan_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north_caucasian_federal_district
+  daman_and_diu:
+    id: daman_and_diu
+    name: daman_and_diu
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  del_norte:
+    id: del_norte
+    name: del_norte
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  detmold:
+    id: detmold
+    name: detmold
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: nordrhein_westfalen
+  distrito-federal:
+    id: distrito-federal
+    name: distrito-federal
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-west
+  djibouti:
+    id: djibouti
+    name: djibouti
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  dolnoslaskie:
+    id: dolnoslaskie
+    name: dolnoslaskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  dominica:
+    id: dominica
+    name: dominica
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  dominican_republic:
+    id: dominican_republic
+    name: dominican_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  drenthe:
+    id: drenthe
+    name: drenthe
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+  dusseldorf:
+    id: dusseldorf
+    name: dusseldorf
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: nordrhein_westfalen
+  east:
+    id: east
+    name: east
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: england
+  east_java:
+    id: east_java
+    name: east_java
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  east_kalimantan:
+    id: east_kalimantan
+    name: east_kalimantan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  east_midlands:
+    id: east_midlands
+    name: east_midlands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: england
+  east_nusa_tenggara:
+    id: east_nusa_tenggara
+    name: east_nusa_tenggara
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  east_timor:
+    id: east_timor
+    name: east_timor
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  el_dorado:
+    id: el_dorado
+    name: el_dorado
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  el_salvador:
+    id: el_salvador
+    name: el_salvador
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  emilia_romagna:
+    id: emilia_romagna
+    name: emilia_romagna
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  england:
+    id: england
+    name: england
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: united_kingdom
+  equatorial_guinea:
+    id: equatorial_guinea
+    name: equatorial_guinea
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  eritrea:
+    id: eritrea
+    name: eritrea
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  espirito-santo:
+    id: espirito-santo
+    name: espirito-santo
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: southeast
+  europe:
+    id: europe
+    name: europe
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - state
+    - poly
+  euskadi:
+    id: euskadi
+    name: euskadi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  extremadura:
+    id: extremadura
+    name: extremadura
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  falkland:
+    id: falkland
+    name: falkland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  far_eastern_federal_district:
+    id: far_eastern_federal_district
+    name: far_eastern_federal_district
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: russia
+  fiji:
+    id: fiji
+    name: fiji
+    files:
+    - osm.pbf
+    - state
+    parent: merge
+  fiji_east:
+    id: fiji_east
+    name: fiji_east
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  fiji_west:
+    id: fiji_west
+    name: fiji_west
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  flanders:
+    id: flanders
+    name: flanders
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: belgium
+  flevoland:
+    id: flevoland
+    name: flevoland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+  france:
+    id: france
+    name: france
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  france_metro_dom_com_nc:
+    id: france_metro_dom_com_nc
+    name: france_metro_dom_com_nc
+    files:
+    - osm.pbf
+    - state
+    parent: merge
+  france_taaf:
+    id: france_taaf
+    name: france_taaf
+    files:
+    - osm.pbf
+    - state
+    - osm.pbf
+    - state
+    - osm.pbf
+    - state
+    - poly
+    - poly
+    parent: africa
+  fresno:
+    id: fresno
+    name: fresno
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  friesland:
+    id: friesland
+    name: friesland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+  friuli_venezia_giulia:
+    id: friuli_venezia_giulia
+    name: friuli_venezia_giulia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  fujian:
+    id: fujian
+    name: fujian
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  gabon:
+    id: gabon
+    name: gabon
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  galicia:
+    id: galicia
+    name: galicia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  gambia:
+    id: gambia
+    name: gambia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  gansu:
+    id: gansu
+    name: gansu
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  gelderland:
+    id: gelderland
+    name: gelderland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+  georgia:
+    id: georgia
+    name: georgia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  germany:
+    id: germany
+    name: germany
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  ghana:
+    id: ghana
+    name: ghana
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  gibraltar:
+    id: gibraltar
+    name: gibraltar
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  glenn:
+    id: glenn
+    name: glenn
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  goa:
+    id: goa
+    name: goa
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  goias:
+    id: goias
+    name: goias
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-west
+  gorontalo:
+    id: gorontalo
+    name: gorontalo
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  greater_london:
+    id: greater_london
+    name: greater_london
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: england
+  grenada:
+    id: grenada
+    name: grenada
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  groningen:
+    id: groningen
+    name: groningen
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+  guadeloupe:
+    id: guadeloupe
+    name: guadeloupe
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  guam:
+    id: guam
+    name: guam
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  guangdong:
+    id: guangdong
+    name: guangdong
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  guangxi:
+    id: guangxi
+    name: guangxi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  guernesey:
+    id: guernesey
+    name: guernesey
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  guinea:
+    id: guinea
+    name: guinea
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  guizhou:
+    id: guizhou
+    name: guizhou
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  gujarat:
+    id: gujarat
+    name: gujarat
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  guyana:
+    id: guyana
+    name: guyana
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  guyane:
+    id: guyane
+    name: guyane
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  hainan:
+    id: hainan
+    name: hainan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  haryana:
+    id: haryana
+    name: haryana
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  hebei:
+    id: hebei
+    name: hebei
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  heilongjiang:
+    id: heilongjiang
+    name: heilongjiang
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  henan:
+    id: henan
+    name: henan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  himachal_pradesh:
+    id: himachal_pradesh
+    name: himachal_pradesh
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  hokkaido:
+    id: hokkaido
+    name: hokkaido
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: japan
+  honduras:
+    id: honduras
+    name: honduras
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  hong_kong:
+    id: hong_kong
+    name: hong_kong
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  hubei:
+    id: hubei
+    name: hubei
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  humboldt:
+    id: humboldt
+    name: humboldt
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  hunan:
+    id: hunan
+    name: hunan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  illes_balears:
+    id: illes_balears
+    name: illes_balears
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  imperial:
+    id: imperial
+    name: imperial
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  india:
+    id: india
+    name: india
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  indonesia:
+    id: indonesia
+    name: indonesia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  ingushetia_republic:
+    id: ingushetia_republic
+    name: ingushetia_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north_caucasian_federal_district
+  inner_mongolia:
+    id: inner_mongolia
+    name: inner_mongolia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  inyo:
+    id: inyo
+    name: inyo
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  ireland:
+    id: ireland
+    name: ireland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  irkutsk_oblast:
+    id: irkutsk_oblast
+    name: irkutsk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  israel:
+    id: israel
+    name: israel
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  israel_and_palestine:
+    id: israel_and_palestine
+    name: israel_and_palestine
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  italy:
+    id: italy
+    name: italy
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  ivanovo_oblast:
+    id: ivanovo_oblast
+    name: ivanovo_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  ivory_coast:
+    id: ivory_coast
+    name: ivory_coast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  jakarta:
+    id: jakarta
+    name: jakarta
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  jamaica:
+    id: jamaica
+    name: jamaica
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  jambi:
+    id: jambi
+    name: jambi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  jammu_and_kashmir:
+    id: jammu_and_kashmir
+    name: jammu_and_kashmir
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  japan:
+    id: japan
+    name: japan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  jersey:
+    id: jersey
+    name: jersey
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  jewish_autonomous_oblast:
+    id: jewish_autonomous_oblast
+    name: jewish_autonomous_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: far_eastern_federal_district
+  jharkhand:
+    id: jharkhand
+    name: jharkhand
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  jiangsu:
+    id: jiangsu
+    name: jiangsu
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  jiangxi:
+    id: jiangxi
+    name: jiangxi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  jihocesky:
+    id: jihocesky
+    name: jihocesky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  jihomoravsky:
+    id: jihomoravsky
+    name: jihomoravsky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  jilin:
+    id: jilin
+    name: jilin
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  kabardino_balkar_republic:
+    id: kabardino_balkar_republic
+    name: kabardino_balkar_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north_caucasian_federal_district
+  kaliningrad_oblast:
+    id: kaliningrad_oblast
+    name: kaliningrad_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northwestern_federal_district
+  kalmykia_republic:
+    id: kalmykia_republic
+    name: kalmykia_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: southern_federal_district
+  kaluga_oblast:
+    id: kaluga_oblast
+    name: kaluga_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  kamchatka_krai:
+    id: kamchatka_krai
+    name: kamchatka_krai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: far_eastern_federal_district
+  kansai:
+    id: kansai
+    name: kansai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: japan
+  kanto:
+    id: kanto
+    name: kanto
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: japan
+  karachay_cherkess_republic:
+    id: karachay_cherkess_republic
+    name: karachay_cherkess_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north_caucasian_federal_district
+  karelia_republic:
+    id: karelia_republic
+    name: karelia_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northwestern_federal_district
+  karlovarsky:
+    id: karlovarsky
+    name: karlovarsky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  karnataka:
+    id: karnataka
+    name: karnataka
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  karnten:
+    id: karnten
+    name: karnten
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: austria
+  kemerovo_oblast:
+    id: kemerovo_oblast
+    name: kemerovo_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  kenya:
+    id: kenya
+    name: kenya
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  kerala:
+    id: kerala
+    name: kerala
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  kern:
+    id: kern
+    name: kern
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  khabarovsk_krai:
+    id: khabarovsk_krai
+    name: khabarovsk_krai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: far_eastern_federal_district
+  khakassia_republic:
+    id: khakassia_republic
+    name: khakassia_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  khanty_mansi_autonomous_okrug:
+    id: khanty_mansi_autonomous_okrug
+    name: khanty_mansi_autonomous_okrug
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: ural_federal_district
+  kings:
+    id: kings
+    name: kings
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  kiribati:
+    id: kiribati
+    name: kiribati
+    files:
+    - osm.pbf
+    - state
+    parent: merge
+  kiribati_east:
+    id: kiribati_east
+    name: kiribati_east
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  kiribati_west:
+    id: kiribati_west
+    name: kiribati_west
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  kirov_oblast:
+    id: kirov_oblast
+    name: kirov_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  koln:
+    id: koln
+    name: koln
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: nordrhein_westfalen
+  komi_republic:
+    id: komi_republic
+    name: komi_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northwestern_federal_district
+  kosicky:
+    id: kosicky
+    name: kosicky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: slovakia
+  kostroma_oblast:
+    id: kostroma_oblast
+    name: kostroma_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  kralovehradecky:
+    id: kralovehradecky
+    name: kralovehradecky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  krasnodar_krai:
+    id: krasnodar_krai
+    name: krasnodar_krai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: southern_federal_district
+  krasnoyarsk_krai:
+    id: krasnoyarsk_krai
+    name: krasnoyarsk_krai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  kujawsko_pomorskie:
+    id: kujawsko_pomorskie
+    name: kujawsko_pomorskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  kurgan_oblast:
+    id: kurgan_oblast
+    name: kurgan_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: ural_federal_district
+  kursk_oblast:
+    id: kursk_oblast
+    name: kursk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  kuwait:
+    id: kuwait
+    name: kuwait
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  kyushu:
+    id: kyushu
+    name: kyushu
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: japan
+  la_rioja:
+    id: la_rioja
+    name: la_rioja
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  lake:
+    id: lake
+    name: lake
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  lakshadweep:
+    id: lakshadweep
+    name: lakshadweep
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  lampung:
+    id: lampung
+    name: lampung
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  laos:
+    id: laos
+    name: laos
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  lassen:
+    id: lassen
+    name: lassen
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  lazio:
+    id: lazio
+    name: lazio
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  leningrad_oblast:
+    id: leningrad_oblast
+    name: leningrad_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northwestern_federal_district
+  lesotho:
+    id: lesotho
+    name: lesotho
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  liaoning:
+    id: liaoning
+    name: liaoning
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  liberecky:
+    id: liberecky
+    name: liberecky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  liguria:
+    id: liguria
+    name: liguria
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  limburg:
+    id: limburg
+    name: limburg
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+  lipetsk_oblast:
+    id: lipetsk_oblast
+    name: lipetsk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  lodzkie:
+    id: lodzkie
+    name: lodzkie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  lombardia:
+    id: lombardia
+    name: lombardia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  los_angeles:
+    id: los_angeles
+    name: los_angeles
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  lubelskie:
+    id: lubelskie
+    name: lubelskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  lubuskie:
+    id: lubuskie
+    name: lubuskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  luxembourg:
+    id: luxembourg
+    name: luxembourg
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  macau:
+    id: macau
+    name: macau
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  madera:
+    id: madera
+    name: madera
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  madhya_pradesh:
+    id: madhya_pradesh
+    name: madhya_pradesh
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  magadan_oblast:
+    id: magadan_oblast
+    name: magadan_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: far_eastern_federal_district
+  maharashtra:
+    id: maharashtra
+    name: maharashtra
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  malawi:
+    id: malawi
+    name: malawi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  malaysia:
+    id: malaysia
+    name: malaysia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  maldives:
+    id: maldives
+    name: maldives
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  mali:
+    id: mali
+    name: mali
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  malopolskie:
+    id: malopolskie
+    name: malopolskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  maluku:
+    id: maluku
+    name: maluku
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  manipur:
+    id: manipur
+    name: manipur
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  maranhao:
+    id: maranhao
+    name: maranhao
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northeast
+  marche:
+    id: marche
+    name: marche
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  mari_el_republic:
+    id: mari_el_republic
+    name: mari_el_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  marin:
+    id: marin
+    name: marin
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  mariposa:
+    id: mariposa
+    name: mariposa
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  marshall-islands:
+    id: marshall-islands
+    name: marshall-islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  marshall_islands:
+    id: marshall_islands
+    name: marshall_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  martinique:
+    id: martinique
+    name: martinique
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  mato-grosso:
+    id: mato-grosso
+    name: mato-grosso
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-west
+  mato-grosso-do-sul:
+    id: mato-grosso-do-sul
+    name: mato-grosso-do-sul
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-west
+  mauritania:
+    id: mauritania
+    name: mauritania
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  mauritius:
+    id: mauritius
+    name: mauritius
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  mayotte:
+    id: mayotte
+    name: mayotte
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  mazowieckie:
+    id: mazowieckie
+    name: mazowieckie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  meghalaya:
+    id: meghalaya
+    name: meghalaya
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  melilla:
+    id: melilla
+    name: melilla
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  mendocino:
+    id: mendocino
+    name: mendocino
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  merced:
+    id: merced
+    name: merced
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  micronesia:
+    id: micronesia
+    name: micronesia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  minas-gerais:
+    id: minas-gerais
+    name: minas-gerais
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: southeast
+  mizoram:
+    id: mizoram
+    name: mizoram
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  modoc:
+    id: modoc
+    name: modoc
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  molise:
+    id: molise
+    name: molise
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  monaco:
+    id: monaco
+    name: monaco
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  mono:
+    id: mono
+    name: mono
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  monterey:
+    id: monterey
+    name: monterey
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  montserrat:
+    id: montserrat
+    name: montserrat
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  moravskoslezsky:
+    id: moravskoslezsky
+    name: moravskoslezsky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  mordovia_republic:
+    id: mordovia_republic
+    name: mordovia_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  moscow:
+    id: moscow
+    name: moscow
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  moscow_oblast:
+    id: moscow_oblast
+    name: moscow_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  mozambique:
+    id: mozambique
+    name: mozambique
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  munster:
+    id: munster
+    name: munster
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: nordrhein_westfalen
+  murmansk_oblast:
+    id: murmansk_oblast
+    name: murmansk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northwestern_federal_district
+  myanmar:
+    id: myanmar
+    name: myanmar
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  nagaland:
+    id: nagaland
+    name: nagaland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  namibia:
+    id: namibia
+    name: namibia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  napa:
+    id: napa
+    name: napa
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  national_capital_territory_of_delhi:
+    id: national_capital_territory_of_delhi
+    name: national_capital_territory_of_delhi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  nauri:
+    id: nauri
+    name: nauri
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  nauru:
+    id: nauru
+    name: nauru
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  nenets_autonomous_okrug:
+    id: nenets_autonomous_okrug
+    name: nenets_autonomous_okrug
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northwestern_federal_district
+  netherlands:
+    id: netherlands
+    name: netherlands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  nevada:
+    id: nevada
+    name: nevada
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  new_caledonia:
+    id: new_caledonia
+    name: new_caledonia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  new_south_wales:
+    id: new_south_wales
+    name: new_south_wales
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  nicaragua:
+    id: nicaragua
+    name: nicaragua
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  niederosterreich:
+    id: niederosterreich
+    name: niederosterreich
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: austria
+  niger:
+    id: niger
+    name: niger
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  ningxia:
+    id: ningxia
+    name: ningxia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  nitriansky:
+    id: nitriansky
+    name: nitriansky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: slovakia
+  niue:
+    id: niue
+    name: niue
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  nizhny_novgorod_oblast:
+    id: nizhny_novgorod_oblast
+    name: nizhny_novgorod_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  noord_brabant:
+    id: noord_brabant
+    name: noord_brabant
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+  noord_holland:
+    id: noord_holland
+    name: noord_holland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+  nordrhein_westfalen:
+    id: nordrhein_westfalen
+    name: nordrhein_westfalen
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: germany
+  norfolk_island:
+    id: norfolk_island
+    name: norfolk_island
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  north:
+    id: north
+    name: north
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: brazil
+  north-america:
+    id: north-america
+    name: north-america
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - state
+    - poly
+  north_caucasian_federal_district:
+    id: north_caucasian_federal_district
+    name: north_caucasian_federal_district
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: russia
+  north_east:
+    id: north_east
+    name: north_east
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: england
+  north_kalimantan:
+    id: north_kalimantan
+    name: north_kalimantan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  north_maluku:
+    id: north_maluku
+    name: north_maluku
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  north_ossetia_alania_republic:
+    id: north_ossetia_alania_republic
+    name: north_ossetia_alania_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north_caucasian_federal_district
+  north_sulawesi:
+    id: north_sulawesi
+    name: north_sulawesi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  north_sumatra:
+    id: north_sumatra
+    name: north_sumatra
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  north_west:
+    id: north_west
+    name: north_west
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: england
+  northeast:
+    id: northeast
+    name: northeast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: brazil
+  northern_ireland:
+    id: northern_ireland
+    name: northern_ireland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: united_kingdom
+  northern_mariana_islands:
+    id: northern_mariana_islands
+    name: northern_mariana_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  northern_territory:
+    id: northern_territory
+    name: northern_territory
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  northwestern_federal_district:
+    id: northwestern_federal_district
+    name: northwestern_federal_district
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: russia
+  novgorod_oblast:
+    id: novgorod_oblast
+    name: novgorod_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northwestern_federal_district
+  novosibirsk_oblast:
+    id: novosibirsk_oblast
+    name: novosibirsk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  oberosterreich:
+    id: oberosterreich
+    name: oberosterreich
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: austria
+  oceania:
+    id: oceania
+    name: oceania
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - state
+    - poly
+  odisha:
+    id: odisha
+    name: odisha
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  olomoucky:
+    id: olomoucky
+    name: olomoucky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  oman:
+    id: oman
+    name: oman
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  omsk_oblast:
+    id: omsk_oblast
+    name: omsk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  opolskie:
+    id: opolskie
+    name: opolskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  orange:
+    id: orange
+    name: orange
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  orenburg_oblast:
+    id: orenburg_oblast
+    name: orenburg_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  oryol_oblast:
+    id: oryol_oblast
+    name: oryol_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  overijssel:
+    id: overijssel
+    name: overijssel
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+  palau:
+    id: palau
+    name: palau
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  palestine:
+    id: palestine
+    name: palestine
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  panama:
+    id: panama
+    name: panama
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  papua:
+    id: papua
+    name: papua
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  papua_new_guinea:
+    id: papua_new_guinea
+    name: papua_new_guinea
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  para:
+    id: para
+    name: para
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north
+  paraguay:
+    id: paraguay
+    name: paraguay
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  paraiba:
+    id: paraiba
+    name: paraiba
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northeast
+  parana:
+    id: parana
+    name: parana
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south
+  pardubicky:
+    id: pardubicky
+    name: pardubicky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  penza_oblast:
+    id: penza_oblast
+    name: penza_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  perm_krai:
+    id: perm_krai
+    name: perm_krai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  pernambuco:
+    id: pernambuco
+    name: pernambuco
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northeast
+  piaui:
+    id: piaui
+    name: piaui
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northeast
+  piemonte:
+    id: piemonte
+    name: piemonte
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  pitcairn:
+    id: pitcairn
+    name: pitcairn
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  placer:
+    id: placer
+    name: placer
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  plumas:
+    id: plumas
+    name: plumas
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  plzensky:
+    id: plzensky
+    name: plzensky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  podkarpackie:
+    id: podkarpackie
+    name: podkarpackie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  podlaskie:
+    id: podlaskie
+    name: podlaskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  poland:
+    id: poland
+    name: poland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  polynesie:
+    id: polynesie
+    name: polynesie
+    files:
+    - osm.pbf
+    - state
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  pomorskie:
+    id: pomorskie
+    name: pomorskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  praha:
+    id: praha
+    name: praha
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  presovsky:
+    id: presovsky
+    name: presovsky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: slovakia
+  primorsky_krai:
+    id: primorsky_krai
+    name: primorsky_krai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: far_eastern_federal_district
+  pskov_oblast:
+    id: pskov_oblast
+    name: pskov_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northwestern_federal_district
+  puducherry:
+    id: puducherry
+    name: puducherry
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  puerto_rico:
+    id: puerto_rico
+    name: puerto_rico
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  puglia:
+    id: puglia
+    name: puglia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  punjab:
+    id: punjab
+    name: punjab
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  qatar:
+    id: qatar
+    name: qatar
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  qinghai:
+    id: qinghai
+    name: qinghai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  quebec:
+    id: quebec
+    name: quebec
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: canada
+  queensland:
+    id: queensland
+    name: queensland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  rajasthan:
+    id: rajasthan
+    name: rajasthan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  region_de_murcia:
+    id: region_de_murcia
+    name: region_de_murcia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: spain
+  reunion:
+    id: reunion
+    name: reunion
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  riau:
+    id: riau
+    name: riau
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  riau_islands:
+    id: riau_islands
+    name: riau_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  rio-de-janeiro:
+    id: rio-de-janeiro
+    name: rio-de-janeiro
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: southeast
+  rio-grande-do-norte:
+    id: rio-grande-do-norte
+    name: rio-grande-do-norte
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northeast
+  rio-grande-do-sul:
+    id: rio-grande-do-sul
+    name: rio-grande-do-sul
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south
+  riverside:
+    id: riverside
+    name: riverside
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  rondonia:
+    id: rondonia
+    name: rondonia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north
+  roraima:
+    id: roraima
+    name: roraima
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north
+  rostov_oblast:
+    id: rostov_oblast
+    name: rostov_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: southern_federal_district
+  russia:
+    id: russia
+    name: russia
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - state
+    - poly
+  rwanda:
+    id: rwanda
+    name: rwanda
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  ryazan_oblast:
+    id: ryazan_oblast
+    name: ryazan_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  sacramento:
+    id: sacramento
+    name: sacramento
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  saint_barthelemy:
+    id: saint_barthelemy
+    name: saint_barthelemy
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  saint_helena_ascension_tristan_da_cunha:
+    id: saint_helena_ascension_tristan_da_cunha
+    name: saint_helena_ascension_tristan_da_cunha
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  saint_kitts_and_nevis:
+    id: saint_kitts_and_nevis
+    name: saint_kitts_and_nevis
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  saint_lucia:
+    id: saint_lucia
+    name: saint_lucia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  saint_martin:
+    id: saint_martin
+    name: saint_martin
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  saint_petersburg:
+    id: saint_petersburg
+    name: saint_petersburg
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northwestern_federal_district
+  saint_pierre_et_miquelon:
+    id: saint_pierre_et_miquelon
+    name: saint_pierre_et_miquelon
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north-america
+  saint_vincent_and_the_grenadines:
+    id: saint_vincent_and_the_grenadines
+    name: saint_vincent_and_the_grenadines
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  sakha_republic:
+    id: sakha_republic
+    name: sakha_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: far_eastern_federal_district
+  sakhalin_oblast:
+    id: sakhalin_oblast
+    name: sakhalin_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: far_eastern_federal_district
+  salzburg:
+    id: salzburg
+    name: salzburg
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: austria
+  samara_oblast:
+    id: samara_oblast
+    name: samara_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  samoa:
+    id: samoa
+    name: samoa
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  san_benito:
+    id: san_benito
+    name: san_benito
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  san_bernardino:
+    id: san_bernardino
+    name: san_bernardino
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  san_diego:
+    id: san_diego
+    name: san_diego
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  san_francisco:
+    id: san_francisco
+    name: san_francisco
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  san_joaquin:
+    id: san_joaquin
+    name: san_joaquin
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  san_luis_obispo:
+    id: san_luis_obispo
+    name: san_luis_obispo
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  san_mateo:
+    id: san_mateo
+    name: san_mateo
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  santa-catarina:
+    id: santa-catarina
+    name: santa-catarina
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south
+  santa_barbara:
+    id: santa_barbara
+    name: santa_barbara
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  santa_clara:
+    id: santa_clara
+    name: santa_clara
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  santa_cruz:
+    id: santa_cruz
+    name: santa_cruz
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  sao-paulo:
+    id: sao-paulo
+    name: sao-paulo
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: southeast
+  sao_tome_and_principe:
+    id: sao_tome_and_principe
+    name: sao_tome_and_principe
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  saratov_oblast:
+    id: saratov_oblast
+    name: saratov_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  sardegna:
+    id: sardegna
+    name: sardegna
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  saudi_arabia:
+    id: saudi_arabia
+    name: saudi_arabia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  senegal:
+    id: senegal
+    name: senegal
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  sergipe:
+    id: sergipe
+    name: sergipe
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northeast
+  seychelles:
+    id: seychelles
+    name: seychelles
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  shaanxi:
+    id: shaanxi
+    name: shaanxi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  shandong:
+    id: shandong
+    name: shandong
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  shanghai:
+    id: shanghai
+    name: shanghai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  shanxi:
+    id: shanxi
+    name: shanxi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  shasta:
+    id: shasta
+    name: shasta
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  shikoku:
+    id: shikoku
+    name: shikoku
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: japan
+  siberian_federal_district:
+    id: siberian_federal_district
+    name: siberian_federal_district
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: russia
+  sichuan:
+    id: sichuan
+    name: sichuan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  sicilia:
+    id: sicilia
+    name: sicilia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  sierra:
+    id: sierra
+    name: sierra
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  sikkim:
+    id: sikkim
+    name: sikkim
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  singapore:
+    id: singapore
+    name: singapore
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  sint_maarten:
+    id: sint_maarten
+    name: sint_maarten
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  siskiyou:
+    id: siskiyou
+    name: siskiyou
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  slaskie:
+    id: slaskie
+    name: slaskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  slovakia:
+    id: slovakia
+    name: slovakia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  smolensk_oblast:
+    id: smolensk_oblast
+    name: smolensk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  solano:
+    id: solano
+    name: solano
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  solomon_islands:
+    id: solomon_islands
+    name: solomon_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  sonoma:
+    id: sonoma
+    name: sonoma
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  south:
+    id: south
+    name: south
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: brazil
+  south-america:
+    id: south-america
+    name: south-america
+    files:
+    - osm.pbf
+    - osm.pbf.md5
+    - state
+    - poly
+  south_africa:
+    id: south_africa
+    name: south_africa
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  south_australia:
+    id: south_australia
+    name: south_australia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  south_east:
+    id: south_east
+    name: south_east
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: england
+  south_georgia_and_south_sandwich:
+    id: south_georgia_and_south_sandwich
+    name: south_georgia_and_south_sandwich
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  south_kalimantan:
+    id: south_kalimantan
+    name: south_kalimantan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  south_sudan:
+    id: south_sudan
+    name: south_sudan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  south_sulawesi:
+    id: south_sulawesi
+    name: south_sulawesi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  south_sumatra:
+    id: south_sumatra
+    name: south_sumatra
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  south_west:
+    id: south_west
+    name: south_west
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: england
+  southeast:
+    id: southeast
+    name: southeast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: brazil
+  southeast_sulawesi:
+    id: southeast_sulawesi
+    name: southeast_sulawesi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  southern_federal_district:
+    id: southern_federal_district
+    name: southern_federal_district
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: russia
+  spain:
+    id: spain
+    name: spain
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  stanislaus:
+    id: stanislaus
+    name: stanislaus
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  stavropol_krai:
+    id: stavropol_krai
+    name: stavropol_krai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north_caucasian_federal_district
+  steiermark:
+    id: steiermark
+    name: steiermark
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: austria
+  stredocesky:
+    id: stredocesky
+    name: stredocesky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  sudan:
+    id: sudan
+    name: sudan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  suriname:
+    id: suriname
+    name: suriname
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  sutter:
+    id: sutter
+    name: sutter
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  sverdlovsk_oblast:
+    id: sverdlovsk_oblast
+    name: sverdlovsk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: ural_federal_district
+  swaziland:
+    id: swaziland
+    name: swaziland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  swietokrzyskie:
+    id: swietokrzyskie
+    name: swietokrzyskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  taaf:
+    id: taaf
+    name: taaf
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  tambov_oblast:
+    id: tambov_oblast
+    name: tambov_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  tamil_nadu:
+    id: tamil_nadu
+    name: tamil_nadu
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  tasmania:
+    id: tasmania
+    name: tasmania
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  tatarstan_republic:
+    id: tatarstan_republic
+    name: tatarstan_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  tehama:
+    id: tehama
+    name: tehama
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  telangana:
+    id: telangana
+    name: telangana
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  tianjin:
+    id: tianjin
+    name: tianjin
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  tibet:
+    id: tibet
+    name: tibet
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  tirol:
+    id: tirol
+    name: tirol
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: austria
+  tocantins:
+    id: tocantins
+    name: tocantins
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north
+  togo:
+    id: togo
+    name: togo
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  tohoku:
+    id: tohoku
+    name: tohoku
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: japan
+  tokelau:
+    id: tokelau
+    name: tokelau
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  tomsk_oblast:
+    id: tomsk_oblast
+    name: tomsk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  tonga:
+    id: tonga
+    name: tonga
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  toscana:
+    id: toscana
+    name: toscana
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  trenciansky:
+    id: trenciansky
+    name: trenciansky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: slovakia
+  trentino_alto_adige:
+    id: trentino_alto_adige
+    name: trentino_alto_adige
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  trinidad_and_tobago:
+    id: trinidad_and_tobago
+    name: trinidad_and_tobago
+    files:
+    - osm.pbf
+    - state
+    - osm.pbf
+    - state
+    - poly
+    - poly
+    parent: central-america
+  trinity:
+    id: trinity
+    name: trinity
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  tripura:
+    id: tripura
+    name: tripura
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  trnavsky:
+    id: trnavsky
+    name: trnavsky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: slovakia
+  tula_oblast:
+    id: tula_oblast
+    name: tula_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  tulare:
+    id: tulare
+    name: tulare
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  tunisia:
+    id: tunisia
+    name: tunisia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  tuolumne:
+    id: tuolumne
+    name: tuolumne
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  turks_and_caicos_islands:
+    id: turks_and_caicos_islands
+    name: turks_and_caicos_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  tuva_republic:
+    id: tuva_republic
+    name: tuva_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  tuvalu:
+    id: tuvalu
+    name: tuvalu
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  tver_oblast:
+    id: tver_oblast
+    name: tver_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  tyumen_oblast:
+    id: tyumen_oblast
+    name: tyumen_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: ural_federal_district
+  udmurt_republic:
+    id: udmurt_republic
+    name: udmurt_republic
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  uganda:
+    id: uganda
+    name: uganda
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  ulyanovsk_oblast:
+    id: ulyanovsk_oblast
+    name: ulyanovsk_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: volga_federal_district
+  umbria:
+    id: umbria
+    name: umbria
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  united_arab_emirates:
+    id: united_arab_emirates
+    name: united_arab_emirates
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: asia
+  united_kingdom:
+    id: united_kingdom
+    name: united_kingdom
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: europe
+  united_states_virgin_islands:
+    id: united_states_virgin_islands
+    name: united_states_virgin_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  ural_federal_district:
+    id: ural_federal_district
+    name: ural_federal_district
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: russia
+  us-west:
+    id: us-west
+    name: us-west
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: north-america
+  usa_virgin_islands:
+    id: usa_virgin_islands
+    name: usa_virgin_islands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central-america
+  ustecky:
+    id: ustecky
+    name: ustecky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  utrecht:
+    id: utrecht
+    name: utrecht
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+  uttar_pradesh:
+    id: uttar_pradesh
+    name: uttar_pradesh
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  uttarakhand:
+    id: uttarakhand
+    name: uttarakhand
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  valle_aosta:
+    id: valle_aosta
+    name: valle_aosta
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  vanuatu:
+    id: vanuatu
+    name: vanuatu
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  veneto:
+    id: veneto
+    name: veneto
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: italy
+  venezuela:
+    id: venezuela
+    name: venezuela
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: south-america
+  ventura:
+    id: ventura
+    name: ventura
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  victoria:
+    id: victoria
+    name: victoria
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  vladimir_oblast:
+    id: vladimir_oblast
+    name: vladimir_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  volga_federal_district:
+    id: volga_federal_district
+    name: volga_federal_district
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: russia
+  volgograd_oblast:
+    id: volgograd_oblast
+    name: volgograd_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: southern_federal_district
+  vologda_oblast:
+    id: vologda_oblast
+    name: vologda_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: northwestern_federal_district
+  vorarlberg:
+    id: vorarlberg
+    name: vorarlberg
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: austria
+  voronezh_oblast:
+    id: voronezh_oblast
+    name: voronezh_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  vysocina:
+    id: vysocina
+    name: vysocina
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  wallis_et_futuna:
+    id: wallis_et_futuna
+    name: wallis_et_futuna
+    files:
+    - osm.pbf
+    - state
+    - osm.pbf
+    - state
+    - poly
+    parent: oceania
+  wallonia_french_community:
+    id: wallonia_french_community
+    name: wallonia_french_community
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: belgium
+  wallonia_german_community:
+    id: wallonia_german_community
+    name: wallonia_german_community
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: belgium
+  warminsko_mazurskie:
+    id: warminsko_mazurskie
+    name: warminsko_mazurskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  west_bengal:
+    id: west_bengal
+    name: west_bengal
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: india
+  west_java:
+    id: west_java
+    name: west_java
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  west_kalimantan:
+    id: west_kalimantan
+    name: west_kalimantan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  west_midlands:
+    id: west_midlands
+    name: west_midlands
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: england
+  west_nusa_tenggara:
+    id: west_nusa_tenggara
+    name: west_nusa_tenggara
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  west_papua:
+    id: west_papua
+    name: west_papua
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  west_sulawesi:
+    id: west_sulawesi
+    name: west_sulawesi
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  west_sumatra:
+    id: west_sumatra
+    name: west_sumatra
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  western_australia:
+    id: western_australia
+    name: western_australia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: australia
+  western_sahara:
+    id: western_sahara
+    name: western_sahara
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  wielkopolskie:
+    id: wielkopolskie
+    name: wielkopolskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  wien:
+    id: wien
+    name: wien
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: austria
+  xinjiang:
+    id: xinjiang
+    name: xinjiang
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  yamalo_nenets_autonomous_okrug:
+    id: yamalo_nenets_autonomous_okrug
+    name: yamalo_nenets_autonomous_okrug
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: ural_federal_district
+  yaroslavl_oblast:
+    id: yaroslavl_oblast
+    name: yaroslavl_oblast
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: central_federal_district
+  yogyakarta:
+    id: yogyakarta
+    name: yogyakarta
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: indonesia
+  yolo:
+    id: yolo
+    name: yolo
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  yorkshire_and_the_humber:
+    id: yorkshire_and_the_humber
+    name: yorkshire_and_the_humber
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: england
+  yuba:
+    id: yuba
+    name: yuba
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: california
+  yunnan:
+    id: yunnan
+    name: yunnan
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  zabaykalsky_krai:
+    id: zabaykalsky_krai
+    name: zabaykalsky_krai
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: siberian_federal_district
+  zachodniopomorskie:
+    id: zachodniopomorskie
+    name: zachodniopomorskie
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: poland
+  zambia:
+    id: zambia
+    name: zambia
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  zeeland:
+    id: zeeland
+    name: zeeland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+  zhejiang:
+    id: zhejiang
+    name: zhejiang
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: china
+  zilinsky:
+    id: zilinsky
+    name: zilinsky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: slovakia
+  zimbabwe:
+    id: zimbabwe
+    name: zimbabwe
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: africa
+  zlinsky:
+    id: zlinsky
+    name: zlinsky
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: czech_republic
+  zuid_holland:
+    id: zuid_holland
+    name: zuid_holland
+    files:
+    - osm.pbf
+    - state
+    - poly
+    parent: netherlands
+`,
+	"gislab.yml": `version: 1
+baseURL: http://be.gis-lab.info/project/osm_dump
+formats:
+  osm.bz2:
+    ext: osm.bz2
+    loc: .osm.bz2
+    basepath: latest/
+    baseurl: http://data.gis-lab.info/osm_dump/dump
+  osm.pbf:
+    ext: osm.pbf
+    loc: .osm.pbf
+    basepath: latest/
+    baseurl: http://data.gis-lab.info/osm_dump/dump
+  poly:
+    ext: poly
+    loc: .poly
+    baseurl: https://raw.githubusercontent.com/nextgis/osmdump_poly/master
+elements:
+  AM:
+    id: AM
+    name: Армения
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  AZ:
+    id: AZ
+    name: Азербайджан
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  BY:
+    id: BY
+    name: Беларусь
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  EE:
+    id: EE
+    name: Эстония
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  GE:
+    id: GE
+    name: Грузия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  KG:
+    id: KG
+    name: Киргизия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  KZ:
+    id: KZ
+    name: Казахстан
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  LT:
+    id: LT
+    name: Литва
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  LV:
+    id: LV
+    name: Латвия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  MD:
+    id: MD
+    name: Молдова
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU:
+    id: RU
+    name: Российская Федерация
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-AD:
+    id: RU-AD
+    name: Адыгея
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-AL:
+    id: RU-AL
+    name: Алтай
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-ALT:
+    id: RU-ALT
+    name: Алтайский край
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-AMU:
+    id: RU-AMU
+    name: Амурская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-ARK:
+    id: RU-ARK
+    name: Архангельская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-AST:
+    id: RU-AST
+    name: Астраханская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-BA:
+    id: RU-BA
+    name: Башкирия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-BEL:
+    id: RU-BEL
+    name: Белгородская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-BRY:
+    id: RU-BRY
+    name: Брянская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-BU:
+    id: RU-BU
+    name: Бурятия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-CE:
+    id: RU-CE
+    name: Чечня
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-CHE:
+    id: RU-CHE
+    name: Челябинская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-CHU:
+    id: RU-CHU
+    name: Чукотский автономный округ
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-CR:
+    id: RU-CR
+    name: Крым
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-CU:
+    id: RU-CU
+    name: Чувашия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-DA:
+    id: RU-DA
+    name: Дагестан
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-IN:
+    id: RU-IN
+    name: Ингушетия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-IRK:
+    id: RU-IRK
+    name: Иркутская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-IVA:
+    id: RU-IVA
+    name: Ивановская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KAM:
+    id: RU-KAM
+    name: Камчатский край
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KB:
+    id: RU-KB
+    name: Кабардино-Балкария
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KC:
+    id: RU-KC
+    name: Карачаево-Черкессия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KDA:
+    id: RU-KDA
+    name: Краснодарский край
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KEM:
+    id: RU-KEM
+    name: Кемеровская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KGD:
+    id: RU-KGD
+    name: Калининградская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KGN:
+    id: RU-KGN
+    name: Курганская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KHA:
+    id: RU-KHA
+    name: Хабаровский край
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KHM:
+    id: RU-KHM
+    name: Ханты-Мансийский автономный округ
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KIR:
+    id: RU-KIR
+    name: Кировская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KK:
+    id: RU-KK
+    name: Хакасия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KL:
+    id: RU-KL
+    name: Калмыкия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KLU:
+    id: RU-KLU
+    name: Калужская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KO:
+    id: RU-KO
+    name: Коми
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KOS:
+    id: RU-KOS
+    name: Костромская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KR:
+    id: RU-KR
+    name: Карелия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KRS:
+    id: RU-KRS
+    name: Курская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-KYA:
+    id: RU-KYA
+    name: Красноярский край
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-LEN:
+    id: RU-LEN
+    name: Ленинградская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-LIP:
+    id: RU-LIP
+    name: Липецкая область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-MAG:
+    id: RU-MAG
+    name: Магаданская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-ME:
+    id: RU-ME
+    name: Марий Эл
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-MO:
+    id: RU-MO
+    name: Мордовия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-MOS:
+    id: RU-MOS
+    name: Московская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-MOW:
+    id: RU-MOW
+    name: Москва
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-MUR:
+    id: RU-MUR
+    name: Мурманская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-NEN:
+    id: RU-NEN
+    name: Ненецкий автономный округ
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-NGR:
+    id: RU-NGR
+    name: Новгородская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-NIZ:
+    id: RU-NIZ
+    name: Нижегородская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-NVS:
+    id: RU-NVS
+    name: Новосибирская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-OMS:
+    id: RU-OMS
+    name: Омская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-ORE:
+    id: RU-ORE
+    name: Оренбургская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-ORL:
+    id: RU-ORL
+    name: Орловская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-PER:
+    id: RU-PER
+    name: Пермский край
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-PNZ:
+    id: RU-PNZ
+    name: Пензенская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-PRI:
+    id: RU-PRI
+    name: Приморский край
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-PSK:
+    id: RU-PSK
+    name: Псковская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-ROS:
+    id: RU-ROS
+    name: Ростовская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-RYA:
+    id: RU-RYA
+    name: Рязанская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-SA:
+    id: RU-SA
+    name: Якутия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-SAK:
+    id: RU-SAK
+    name: Сахалинская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-SAM:
+    id: RU-SAM
+    name: Самарская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-SAR:
+    id: RU-SAR
+    name: Саратовская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-SE:
+    id: RU-SE
+    name: Северная Осетия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-SEV:
+    id: RU-SEV
+    name: Севастополь
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-SMO:
+    id: RU-SMO
+    name: Смоленская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-SPE:
+    id: RU-SPE
+    name: Санкт-Петербург
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-STA:
+    id: RU-STA
+    name: Ставропольский край
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-SVE:
+    id: RU-SVE
+    name: Свердловская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-TA:
+    id: RU-TA
+    name: Татарстан
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-TAM:
+    id: RU-TAM
+    name: Тамбовская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-TOM:
+    id: RU-TOM
+    name: Томская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-TUL:
+    id: RU-TUL
+    name: Тульская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-TVE:
+    id: RU-TVE
+    name: Тверская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-TY:
+    id: RU-TY
+    name: Тува
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-TYU:
+    id: RU-TYU
+    name: Тюменская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-UD:
+    id: RU-UD
+    name: Удмуртия
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-ULY:
+    id: RU-ULY
+    name: Ульяновская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-VGG:
+    id: RU-VGG
+    name: Волгоградская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-VLA:
+    id: RU-VLA
+    name: Владимирская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-VLG:
+    id: RU-VLG
+    name: Вологодская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-VOR:
+    id: RU-VOR
+    name: Воронежская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-YAN:
+    id: RU-YAN
+    name: Ямало-Ненецкий автономный округ
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-YAR:
+    id: RU-YAR
+    name: Ярославская область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-YEV:
+    id: RU-YEV
+    name: Еврейская автономная область
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  RU-ZAB:
+    id: RU-ZAB
+    name: Забайкальский край
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  TJ:
+    id: TJ
+    name: Таджикистан
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  TM:
+    id: TM
+    name: Туркмения
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  UA:
+    id: UA
+    name: Украина
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  UZ:
+    id: UZ
+    name: Узбекистан
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+  local:
+    id: local
+    name: локальное покрытие
+    files:
+    - osm.pbf
+    - osm.bz2
+    - poly
+`,
+	"geofabrik.rules.yml": `# Rules applied by "download-geofabrik generate" for geofabrik.
+# rename: change id (and name) of an element according to its parent
+# reparent: move elements under another parent
+# file: name used in URLs when it differ from id
+# inject: elements added when they are not crawled
+rename:
+- id: georgia
+  parent: europe
+  newid: georgia-eu
+  name: Georgia (Europe country)
+- id: georgia
+  parent: us
+  newid: georgia-us
+  name: Georgia (US State)
+reparent:
+# US states, see #10
+- parent: us
+  ids:
+  - alabama
+  - alaska
+  - arizona
+  - arkansas
+  - california
+  - colorado
+  - connecticut
+  - delaware
+  - district-of-columbia
+  - florida
+  - georgia-us
+  - hawaii
+  - idaho
+  - illinois
+  - indiana
+  - iowa
+  - kansas
+  - kentucky
+  - louisiana
+  - maine
+  - maryland
+  - massachusetts
+  - michigan
+  - minnesota
+  - mississippi
+  - missouri
+  - montana
+  - nebraska
+  - nevada
+  - new-hampshire
+  - new-jersey
+  - new-mexico
+  - new-york
+  - north-carolina
+  - north-dakota
+  - ohio
+  - oklahoma
+  - oregon
+  - pennsylvania
+  - puerto-rico
+  - rhode-island
+  - south-carolina
+  - south-dakota
+  - tennessee
+  - texas
+  - utah
+  - vermont
+  - virginia
+  - washington
+  - west-virginia
+  - wisconsin
+  - wyoming
+file:
+  georgia-eu: georgia
+  georgia-us: georgia
+inject:
+# see #10
+- id: us
+  name: United States of America
+  parent: north-america
+  meta: true
+`,
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Change is a modified value of an element or of a Config.
+type Change struct {
+	ID  string `json:"id"`
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// FormatsChange list formats added or removed for an element or a Config.
+type FormatsChange struct {
+	ID      string   `json:"id,omitempty"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	Changed []string `json:"changed,omitempty"`
+}
+
+// ConfigDiff contain all differences between two Config.
+type ConfigDiff struct {
+	BaseURL  *Change         `json:"baseURL,omitempty"`
+	Formats  *FormatsChange  `json:"formats,omitempty"` // Config.Formats
+	Added    []string        `json:"added,omitempty"`
+	Removed  []string        `json:"removed,omitempty"`
+	Parents  []Change        `json:"parents,omitempty"`
+	Names    []Change        `json:"names,omitempty"`
+	Elements []FormatsChange `json:"elements,omitempty"` // Element.Formats
+}
+
+// IsEmpty is true when both configs are the same.
+func (d *ConfigDiff) IsEmpty() bool {
+	return d.BaseURL == nil && d.Formats == nil && len(d.Added) == 0 && len(d.Removed) == 0 &&
+		len(d.Parents) == 0 && len(d.Names) == 0 && len(d.Elements) == 0
+}
+
+// elementKeys return sorted IDs of elements.
+func elementKeys(elements map[string]Element) []string {
+	keys := make([]string, 0, len(elements))
+	for k := range elements {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// formatKeys return sorted IDs of formats.
+func formatKeys(formats map[string]format) []string {
+	keys := make([]string, 0, len(formats))
+	for k := range formats {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sliceDiff return items only in a and items only in b.
+func sliceDiff(a, b []string) (onlyA, onlyB []string) {
+	for _, item := range a {
+		if !stringInSlice(&item, &b) {
+			onlyA = append(onlyA, item)
+		}
+	}
+	for _, item := range b {
+		if !stringInSlice(&item, &a) {
+			onlyB = append(onlyB, item)
+		}
+	}
+	return onlyA, onlyB
+}
+
+// diffConfig compare oldC with newC.
+func diffConfig(oldC, newC *Config) *ConfigDiff {
+	d := new(ConfigDiff)
+	if oldC.BaseURL != newC.BaseURL {
+		d.BaseURL = &Change{ID: "baseURL", Old: oldC.BaseURL, New: newC.BaseURL}
+	}
+	formats := new(FormatsChange)
+	formats.Removed, formats.Added = sliceDiff(formatKeys(oldC.Formats), formatKeys(newC.Formats))
+	for _, k := range formatKeys(oldC.Formats) {
+		if f, ok := newC.Formats[k]; ok && !reflect.DeepEqual(f, oldC.Formats[k]) {
+			formats.Changed = append(formats.Changed, k)
+		}
+	}
+	if len(formats.Added) != 0 || len(formats.Removed) != 0 || len(formats.Changed) != 0 {
+		d.Formats = formats
+	}
+	for _, id := range elementKeys(newC.Elements) {
+		if _, ok := oldC.Elements[id]; !ok {
+			d.Added = append(d.Added, id)
+		}
+	}
+	for _, id := range elementKeys(oldC.Elements) {
+		oldE := oldC.Elements[id]
+		newE, ok := newC.Elements[id]
+		if !ok {
+			d.Removed = append(d.Removed, id)
+			continue
+		}
+		if oldE.Parent != newE.Parent {
+			d.Parents = append(d.Parents, Change{ID: id, Old: oldE.Parent, New: newE.Parent})
+		}
+		if oldE.Name != newE.Name {
+			d.Names = append(d.Names, Change{ID: id, Old: oldE.Name, New: newE.Name})
+		}
+		removed, added := sliceDiff(oldE.Formats, newE.Formats)
+		if len(added) != 0 || len(removed) != 0 {
+			d.Elements = append(d.Elements, FormatsChange{ID: id, Added: added, Removed: removed})
+		}
+	}
+	return d
+}
+
+// printDiff write d in a human readable way.
+func printDiff(w io.Writer, d *ConfigDiff) {
+	if d.BaseURL != nil {
+		fmt.Fprintf(w, "~ baseURL: %s -> %s\n", d.BaseURL.Old, d.BaseURL.New)
+	}
+	if d.Formats != nil {
+		for _, f := range d.Formats.Added {
+			fmt.Fprintf(w, "+ format %s\n", f)
+		}
+		for _, f := range d.Formats.Removed {
+			fmt.Fprintf(w, "- format %s\n", f)
+		}
+		for _, f := range d.Formats.Changed {
+			fmt.Fprintf(w, "~ format %s\n", f)
+		}
+	}
+	for _, id := range d.Added {
+		fmt.Fprintf(w, "+ %s\n", id)
+	}
+	for _, id := range d.Removed {
+		fmt.Fprintf(w, "- %s\n", id)
+	}
+	for _, c := range d.Parents {
+		fmt.Fprintf(w, "~ %s: parent %q -> %q\n", c.ID, c.Old, c.New)
+	}
+	for _, c := range d.Names {
+		fmt.Fprintf(w, "~ %s: name %q -> %q\n", c.ID, c.Old, c.New)
+	}
+	for _, c := range d.Elements {
+		var changes []string
+		for _, f := range c.Added {
+			changes = append(changes, "+"+f)
+		}
+		for _, f := range c.Removed {
+			changes = append(changes, "-"+f)
+		}
+		fmt.Fprintf(w, "~ %s: formats %s\n", c.ID, strings.Join(changes, " "))
+	}
+}
+
+// diffCommand compare two config files.
+// Return true if they differ.
+func diffCommand(w io.Writer, oldFile, newFile string, asJSON bool) (bool, error) {
+	oldC, err := loadConfig(oldFile)
+	if err != nil {
+		return false, err
+	}
+	newC, err := loadConfig(newFile)
+	if err != nil {
+		return false, err
+	}
+	d := diffConfig(oldC, newC)
+	if asJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(d); err != nil {
+			return false, err
+		}
+	} else {
+		printDiff(w, d)
+	}
+	return !d.IsEmpty(), nil
+}
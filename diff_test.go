@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func Test_diffConfig(t *testing.T) {
+	oldC := &Config{
+		BaseURL: "https://my.base.url",
+		Formats: map[string]format{
+			"osm.pbf": {ID: "osm.pbf", Loc: ".osm.pbf"},
+			"poly":    {ID: "poly", Loc: ".poly"},
+			"kml":     {ID: "kml", Loc: ".kml"},
+		},
+		Elements: map[string]Element{
+			"africa":  {ID: "africa", Name: "Africa", Formats: []string{"osm.pbf", "poly"}},
+			"georgia": {ID: "georgia", Name: "Georgia", Formats: []string{"osm.pbf"}, Parent: "europe"},
+			"europe":  {ID: "europe", Name: "Europe", Formats: []string{"osm.pbf"}},
+		},
+	}
+	newC := &Config{
+		BaseURL: "https://my.new.url",
+		Formats: map[string]format{
+			"osm.pbf": {ID: "osm.pbf", Loc: "-latest.osm.pbf"},
+			"poly":    {ID: "poly", Loc: ".poly"},
+			"state":   {ID: "state", Loc: ".state.txt"},
+		},
+		Elements: map[string]Element{
+			"africa":  {ID: "africa", Name: "Africa", Formats: []string{"osm.pbf", "state"}},
+			"georgia": {ID: "georgia", Name: "Georgia (Europe country)", Formats: []string{"osm.pbf"}, Parent: "asia"},
+			"asia":    {ID: "asia", Name: "Asia", Formats: []string{"osm.pbf"}},
+		},
+	}
+	tests := []struct {
+		name string
+		oldC *Config
+		newC *Config
+		want *ConfigDiff
+	}{
+		{name: "same config", oldC: oldC, newC: oldC, want: &ConfigDiff{}},
+		{
+			name: "all differences",
+			oldC: oldC,
+			newC: newC,
+			want: &ConfigDiff{
+				BaseURL:  &Change{ID: "baseURL", Old: "https://my.base.url", New: "https://my.new.url"},
+				Formats:  &FormatsChange{Added: []string{"state"}, Removed: []string{"kml"}, Changed: []string{"osm.pbf"}},
+				Added:    []string{"asia"},
+				Removed:  []string{"europe"},
+				Parents:  []Change{{ID: "georgia", Old: "europe", New: "asia"}},
+				Names:    []Change{{ID: "georgia", Old: "Georgia", New: "Georgia (Europe country)"}},
+				Elements: []FormatsChange{{ID: "africa", Added: []string{"state"}, Removed: []string{"poly"}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffConfig(tt.oldC, tt.newC)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffConfig() = %+v, want %+v", got, tt.want)
+			}
+			if got.IsEmpty() != (tt.oldC == tt.newC) {
+				t.Errorf("diffConfig().IsEmpty() = %v", got.IsEmpty())
+			}
+		})
+	}
+}
+
+func Test_printDiff(t *testing.T) {
+	d := &ConfigDiff{
+		Formats:  &FormatsChange{Added: []string{"state"}},
+		Added:    []string{"asia"},
+		Removed:  []string{"europe"},
+		Parents:  []Change{{ID: "georgia", Old: "europe", New: "asia"}},
+		Names:    []Change{{ID: "georgia", Old: "Georgia", New: "Georgia (Europe country)"}},
+		Elements: []FormatsChange{{ID: "africa", Added: []string{"state"}, Removed: []string{"poly"}}},
+	}
+	want := `+ format state
++ asia
+- europe
+~ georgia: parent "europe" -> "asia"
+~ georgia: name "Georgia" -> "Georgia (Europe country)"
+~ africa: formats +state -poly
+`
+	var buf bytes.Buffer
+	printDiff(&buf, d)
+	if buf.String() != want {
+		t.Errorf("printDiff() = %v, want %v", buf.String(), want)
+	}
+}
+
+func Test_diffCommand(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldFile    string
+		newFile    string
+		asJSON     bool
+		wantDiffer bool
+		wantErr    bool
+	}{
+		{name: "same file", oldFile: "./geofabrik.yml", newFile: "./geofabrik.yml", wantDiffer: false},
+		{name: "different services", oldFile: "./geofabrik.yml", newFile: "./openstreetmap.fr.yml", wantDiffer: true},
+		{name: "different services JSON", oldFile: "./geofabrik.yml", newFile: "./gislab.yml", asJSON: true, wantDiffer: true},
+		{name: "file not exist", oldFile: "./geofabrik.yml", newFile: "./this_file_not_exists", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			got, err := diffCommand(&buf, tt.oldFile, tt.newFile, tt.asJSON)
+			if err != nil != tt.wantErr {
+				t.Errorf("diffCommand() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.wantDiffer {
+				t.Errorf("diffCommand() = %v, want %v", got, tt.wantDiffer)
+			}
+			if tt.asJSON {
+				var d ConfigDiff
+				if err := json.Unmarshal(buf.Bytes(), &d); err != nil {
+					t.Errorf("diffCommand() output is not JSON: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -1,16 +1,14 @@
 package main
 
 import (
-	"crypto/md5"
-	"encoding/hex"
+	"context"
 	"fmt"
-	"io"
-	"io/ioutil"
 	"log"
 	"os"
 	"sort"
 	"strings"
 
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
 	"github.com/olekukonko/tablewriter"
 	"gopkg.in/alecthomas/kingpin.v2"
 )
@@ -18,17 +16,32 @@ import (
 const version = "2.3.0"
 
 var (
-	app         = kingpin.New("download-geofabrik", "A command-line tool for downloading OSM files.")
-	fService    = app.Flag("service", "Can switch to another service. You can use \"geofabrik\", \"openstreetmap.fr\" or \"gislab\". It automatically change config file if -c is unused.").Default("geofabrik").String()
-	fConfig     = app.Flag("config", "Set Config file.").Default("./geofabrik.yml").Short('c').String()
-	fNodownload = app.Flag("nodownload", "Do not download file (test only)").Short('n').Bool()
-	fVerbose    = app.Flag("verbose", "Be verbose").Short('v').Bool()
-	fQuiet      = app.Flag("quiet", "Be quiet").Short('q').Bool()
-	fProgress   = app.Flag("progress", "Add a progress bar").Bool()
-	fProxyHTTP  = app.Flag("proxy-http", "Use http proxy, format: proxy_address:port").Default("").String()
-	fProxySock5 = app.Flag("proxy-sock5", "Use Sock5 proxy, format: proxy_address:port").Default("").String()
-	fProxyUser  = app.Flag("proxy-user", "Proxy user").Default("").String()
-	fProxyPass  = app.Flag("proxy-pass", "Proxy password").Default("").String()
+	app          = kingpin.New("download-geofabrik", "A command-line tool for downloading OSM files.")
+	fService     = app.Flag("service", "Can switch to another service. You can use \"geofabrik\", \"geofabrik-internal\", \"openstreetmap.fr\" or \"gislab\". It automatically change config file if -c is unused.").Envar(envPrefix + "SERVICE").Default("geofabrik").String()
+	fConfig      = app.Flag("config", "Set Config file.").Envar(envPrefix + "CONFIG").Default("./geofabrik.yml").Short('c').String()
+	fNodownload  = app.Flag("nodownload", "Do not download file (test only)").Short('n').Bool()
+	fVerbose     = app.Flag("verbose", "Be verbose").Envar(envPrefix + "VERBOSE").Short('v').Bool()
+	fQuiet       = app.Flag("quiet", "Be quiet").Envar(envPrefix + "QUIET").Short('q').Bool()
+	fProgress    = app.Flag("progress", "Add a progress bar").Envar(envPrefix + "PROGRESS").Bool()
+	fProxy       = app.Flag("proxy", "Use this proxy, format: scheme://[user:password@]proxy_address:port with http, https or socks5 scheme. Default is HTTP_PROXY or HTTPS_PROXY").Envar(envPrefix + "PROXY").Default("").String()
+	fProxyHTTP   = app.Flag("proxy-http", "Use http proxy, format: [http://][user:password@]proxy_address:port").Envar(envPrefix + "PROXY_HTTP").Default("").String()
+	fProxySock5  = app.Flag("proxy-sock5", "Use Sock5 proxy, format: [socks5://][user:password@]proxy_address:port").Envar(envPrefix + "PROXY_SOCK5").Default("").String()
+	fProxyUser   = app.Flag("proxy-user", "Proxy user").Envar(envPrefix + "PROXY_USER").Default("").String()
+	fProxyPass   = app.Flag("proxy-pass", "Proxy password").Envar(envPrefix + "PROXY_PASS").Default("").String()
+	fCacheTTL    = app.Flag("cache-ttl", "Warn or refresh when the cached config is older, 0 to disable").Envar(envPrefix + "CACHE_TTL").Default("168h").Duration()
+	fAutoRefresh = app.Flag("auto-refresh", "Refresh the cached config when it's too old instead of warning").Envar(envPrefix + "AUTO_REFRESH").Bool()
+	fLimitRate   = app.Flag("limit-rate", "Limit the speed of all downloads together, in bytes by second like 500K or 5M, 0 for no limit").Envar(envPrefix + "LIMIT_RATE").Default("0").String()
+	fUserAgent   = app.Flag("user-agent", "User-Agent sent with requests").Envar(envPrefix + "USER_AGENT").Default(userAgent).String()
+	fHeaders     = app.Flag("header", "Add this header to requests, format: \"Name: value\", can be repeated").Envar(envPrefix + "HEADER").Strings()
+	fConnTimeout = app.Flag("connect-timeout", "Timeout to connect to servers, 0 to disable").Envar(envPrefix + "CONNECT_TIMEOUT").Default("30s").Duration()
+	fTLSTimeout  = app.Flag("tls-timeout", "Timeout of the TLS handshake, 0 to disable").Envar(envPrefix + "TLS_TIMEOUT").Default("10s").Duration()
+	fReadTimeout = app.Flag("read-timeout", "Fail when nothing is received during this time, 0 to disable").Envar(envPrefix + "READ_TIMEOUT").Default("60s").Duration()
+	fTimeout     = app.Flag("timeout", "Timeout of a whole request, download included, 0 to disable").Envar(envPrefix + "TIMEOUT").Default("0").Duration()
+	fCACert      = app.Flag("ca-cert", "Trust certificates of this PEM file, like a CA bundle of a private mirror").Envar(envPrefix + "CA_CERT").Default("").String()
+	fCert        = app.Flag("cert", "Client certificate, PEM file").Envar(envPrefix + "CERT").Default("").String()
+	fKey         = app.Flag("key", "Key of the client certificate, PEM file. Default is --cert").Envar(envPrefix + "KEY").Default("").String()
+	fCookies     = app.Flag("cookies", "OSM login cookie file of geofabrik-internal, only sent to its server").Envar(envPrefix + "COOKIES").Default("").String()
+	fLogFormat   = app.Flag("log-format", "Log format: text, or json to write one event by line").Envar(envPrefix+"LOG_FORMAT").Default("text").Enum("text", "json")
 
 	update = app.Command("update", "Update geofabrik.yml from github *** DEPRECATED you should prefer use generate ***")
 	fURL   = update.Flag("url", "Url for config source").Default("https://raw.githubusercontent.com/julien-noblet/download-geofabrik/master/geofabrik.yml").String()
@@ -36,24 +49,56 @@ var (
 	list = app.Command("list", "Show elements available")
 	lmd  = list.Flag("markdown", "generate list in Markdown format").Bool()
 
-	download = app.Command("download", "Download element") //TODO : add d as command
-	delement = download.Arg("element", "OSM element").Required().String()
-	dosmBz2  = download.Flag("osm.bz2", "Download osm.bz2 if available").Short('B').Bool()
-	dshpZip  = download.Flag("shp.zip", "Download shp.zip if available").Short('S').Bool()
-	dosmPbf  = download.Flag("osm.pbf", "Download osm.pbf (default)").Short('P').Bool()
-	doshPbf  = download.Flag("osh.pbf", "Download osh.pbf").Short('H').Bool()
-	dstate   = download.Flag("state", "Download state.txt file").Short('s').Bool()
-	dpoly    = download.Flag("poly", "Download poly file").Short('p').Bool()
-	dkml     = download.Flag("kml", "Download kml file").Short('k').Bool()
-	dCheck   = download.Flag("check", "Control with checksum (default) Use --no-check to discard control").Default("true").Bool()
+	download    = app.Command("download", "Download element") //TODO : add d as command
+	delement    = download.Arg("element", "OSM element").Required().String()
+	dosmBz2     = download.Flag("osm.bz2", "Download osm.bz2 if available").Short('B').Bool()
+	dshpZip     = download.Flag("shp.zip", "Download shp.zip if available").Short('S').Bool()
+	dosmPbf     = download.Flag("osm.pbf", "Download osm.pbf (default)").Short('P').Bool()
+	doshPbf     = download.Flag("osh.pbf", "Download osh.pbf").Short('H').Bool()
+	dstate      = download.Flag("state", "Download state.txt file").Short('s').Bool()
+	dpoly       = download.Flag("poly", "Download poly file").Short('p').Bool()
+	dkml        = download.Flag("kml", "Download kml file").Short('k').Bool()
+	dFormats    = download.Flag("format", "Download this format, can be repeated (-f osm.pbf -f poly). See formats in config file").Short('f').Strings()
+	dAllFormats = download.Flag("all-formats", "Download every format available for element, except checksums").Bool()
+	dPrefer     = download.Flag("prefer", "Download the first of these formats available for element, like osm.pbf,osm.bz2").Envar(envPrefix + "PREFER").Default("").String()
+	dCheck      = download.Flag("check", "Control with checksum (default) Use --no-check to discard control").Envar(envPrefix + "CHECK").Default("true").Bool()
+	dResume     = download.Flag("resume", "Keep partial files (.part) when a download fails or is interrupted, and continue them next time").Envar(envPrefix + "RESUME").Bool()
+	dSegments   = download.Flag("segments", "Download each file with N ranges in parallel, when the server accept ranges").Envar(envPrefix + "SEGMENTS").Default("1").Int()
+	dOutputDir  = download.Flag("output-dir", "Directory where files are downloaded").Envar(envPrefix + "OUTPUT_DIR").Default("").String()
+	dWarn       = download.Flag("warn-size", "Warn before downloading files bigger than this size, 0 to disable").Default("1G").String()
 
 	generate = app.Command("generate", "Generate a new config file")
+	gRecord  = generate.Flag("record", "Save every fetched page into this directory").Default("").String()
+	gReplay  = generate.Flag("replay", "Use pages saved with --record from this directory instead of the web").Default("").String()
+	gRoot    = generate.Flag("root", "Only crawl this element and its children, then merge them into the existing config file").Default("").String()
+	gRules   = generate.Flag("rules", "Rules file used to fix elements. Default is <service>.rules.yml if it exists").Default("").String()
+
+	diff     = app.Command("diff", "Compare two config files, exit with 7 if they differ")
+	diffOld  = diff.Arg("old", "Old config file").Required().String()
+	diffNew  = diff.Arg("new", "New config file").Required().String()
+	diffJSON = diff.Flag("json", "Output differences in JSON").Bool()
+
+	config        = app.Command("config", "Manage config files")
+	configExport  = config.Command("export", "Write config files shipped in the binary")
+	ceServices    = configExport.Arg("service", "Services to export, all if not set").Strings()
+	ceDir         = configExport.Flag("dir", "Directory where config files are written").Default(".").String()
+	ceForce       = configExport.Flag("force", "Replace existing files").Bool()
+	configConvert = config.Command("convert", "Convert a config file, formats are found with extensions (.yml, .json or .toml)")
+	ccInput       = configConvert.Arg("input", "Config file to convert").Required().String()
+	ccOutput      = configConvert.Arg("output", "Converted config file").Required().String()
+	configMigrate = config.Command("migrate", "Upgrade a config file to the latest version")
+	cmFile        = configMigrate.Arg("file", "Config file to upgrade, default is --config").String()
+	configRefresh = config.Command("refresh", "Generate config of --service into the user cache")
+
+	validate = app.Command("validate", "Check config file, exit with 8 if it's not valid")
+	vURLs    = validate.Flag("check-urls", "Also check every URL with a HEAD request").Bool()
+	vJobs    = validate.Flag("jobs", "Number of URLs checked in parallel").Envar(envPrefix + "JOBS").Default("8").Int()
 )
 
 func listAllRegions(c Config, format string) {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetHeader([]string{"ShortName", "Is in", "Long Name", "formats"})
+	table.SetHeader([]string{"ShortName", "Is in", "Long Name", "formats", "osm.pbf size"})
 	if format == "Markdown" {
 		table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
 		table.SetCenterSeparator("|")
@@ -65,23 +110,34 @@ func listAllRegions(c Config, format string) {
 		i++
 	}
 	keys.Sort()
+	columns := formatColumns(&c)
 	for _, item := range keys {
-		table.Append([]string{item, c.Elements[c.Elements[item].Parent].Name, c.Elements[item].Name, miniFormats(c.Elements[item].Formats)})
+		size := ""
+		if info, ok := c.Elements[item].Info["osm.pbf"]; ok && info.Size != 0 {
+			size = humanSize(info.Size)
+		}
+		table.Append([]string{item, c.Elements[c.Elements[item].Parent].Name, c.Elements[item].Name, miniFormats(c.Elements[item].Formats, columns...), size})
 	}
 	table.Render()
 	fmt.Printf("Total elements: %#v\n", len(c.Elements))
 }
 
 // UpdateConfig : simple script to download lastest config from repo
-func UpdateConfig(myURL string, myconfig string) error {
+func UpdateConfig(ctx context.Context, myURL string, myconfig string) error {
 	if !*fQuiet {
 		log.Print("*** DEPRECATED you should prefer use generate ***")
 	}
-	err := downloadFromURL(myURL, myconfig)
+	err := downloadFromURL(ctx, myURL, myconfig)
+	if err == context.Canceled {
+		return err
+	}
 	if err != nil {
 		if *fVerbose {
 			log.Println(err)
 		}
+		if kind := geofabrik.Cause(err); kind != err {
+			return geofabrik.Errorf(kind, "Can't updating %v please use generate", myconfig)
+		}
 		return fmt.Errorf("Can't updating %v please use generate", myconfig)
 	}
 	if *fVerbose && !*fQuiet {
@@ -91,102 +147,214 @@ func UpdateConfig(myURL string, myconfig string) error {
 }
 
 func checkService() bool {
-	switch *fService {
-	case "geofabrik":
-		return true
-	case "openstreetmap.fr":
-		if strings.EqualFold(*fConfig, "./geofabrik.yml") {
-			*fConfig = "./openstreetmap.fr.yml"
-		}
-		return true
-	case "gislab":
-		if strings.EqualFold(*fConfig, "./geofabrik.yml") {
-			*fConfig = "./gislab.yml"
-		}
-		return true
+	configFile, ok := serviceConfigs[*fService]
+	if !ok {
+		return false
 	}
-	return false
+	if strings.EqualFold(*fConfig, "./geofabrik.yml") {
+		*fConfig = "./" + configFile
+	}
+	return true
 }
 
+// catch log err and exit with its exit code, see exitCode.
+// It's only used in main, other functions return errors.
 func catch(err error) {
-	if err != nil {
-		log.Fatalln(err.Error()) // Fatalln is better than Panic or Println
-		// Println only log but dont do exit(1),
-		// Panic add a lot of verbose detail for debug but it's too aggressive!
+	if err == nil {
+		return
+	}
+	if events != nil {
+		events.Error(err)
+	} else if err != context.Canceled {
+		log.Println(err.Error())
+	} else if !*fQuiet {
+		log.Println("Interrupted")
 	}
+	os.Exit(exitCode(err))
 }
 
-func listCommand() {
+func listCommand() error {
 	var format = ""
 	if *lmd {
 		format = "Markdown"
 	}
 	configPtr, err := loadConfig(*fConfig)
-	catch(err)
+	if err != nil {
+		return err
+	}
 	listAllRegions(*configPtr, format)
+	return nil
+}
+
+// warnSize log size of element.format if known.
+// It's a warning if bigger than limit.
+func warnSize(c *Config, element string, format string, limit int64) {
+	myElem, err := c.FindElement(element)
+	if err != nil || *fQuiet {
+		return // FindElement error will be catched later
+	}
+	info, ok := myElem.Info[format]
+	if !ok || info.Size == 0 {
+		return
+	}
+	if limit > 0 && info.Size > limit {
+		log.Printf("Warning: %s.%s is %s", element, format, humanSize(info.Size))
+	} else if *fVerbose {
+		log.Printf("%s.%s is %s", element, format, humanSize(info.Size))
+	}
 }
 
-func downloadCommand() {
+// localFile give the path where element.format is downloaded.
+func localFile(format string) string {
+	return geofabrik.LocalFile(*dOutputDir, *delement, format)
+}
+
+// downloadFormat download element.format with client.
+// If server return 404, offer to regenerate the config and try again.
+func downloadFormat(ctx context.Context, client *geofabrik.Client, format string) error {
+	err := client.DownloadFormat(ctx, *delement, format, downloadOptions())
+	if _, ok := err.(geofabrik.NotFoundError); ok && offerRegenerate() {
+		if client.Config, err = regenerateConfig(ctx); err != nil {
+			return err
+		}
+		err = client.DownloadFormat(ctx, *delement, format, downloadOptions())
+	}
+	return err
+}
+
+func downloadCommand(ctx context.Context) error {
 	configPtr, err := loadConfig(*fConfig)
-	catch(err)
+	if err != nil {
+		return err
+	}
+	if *dOutputDir != "" {
+		if err = os.MkdirAll(*dOutputDir, 0755); err != nil {
+			return err
+		}
+	}
+	myElem, err := configPtr.FindElement(*delement)
+	if err != nil {
+		return err
+	}
 	formatFile := getFormats()
+	if *dAllFormats { // before checkFormats, default osm.pbf may not be in config
+		*formatFile = elementFormats(myElem)
+	}
+	if err = checkFormats(configPtr, *formatFile); err != nil {
+		return err
+	}
+	if len(*formatFile) == 0 { // --prefer is only used without format flags
+		// Formats not in this config are dropped, --prefer may come from settings for all services
+		prefer := definedFormats(configPtr, splitFormats(*dPrefer))
+		if len(prefer) == 0 {
+			prefer = []string{"osm.pbf"}
+		}
+		chosen, err := preferredFormat(myElem, prefer)
+		if err != nil {
+			return err
+		}
+		if !*fQuiet && *dPrefer != "" {
+			log.Printf("Using %s for %s", chosen, myElem.ID)
+		}
+		*formatFile = []string{chosen}
+	}
+	if *formatFile, err = availableFormats(myElem, *formatFile); err != nil {
+		return err
+	}
+	client, err := downloadClient(configPtr)
+	if err != nil {
+		return err
+	}
+	client.Config = configPtr
 	for _, format := range *formatFile {
-		if ok, _, _ := isHashable(configPtr, format); *dCheck && ok {
-			if fileExist(*delement + "." + format) {
-				if !downloadChecksum(format) {
-					if !*fQuiet {
-						log.Println("Checksum mismatch, re-downloading", *delement+"."+format)
-					}
-					myElem, err := findElem(configPtr, *delement)
-					catch(err)
-					myURL, err := elem2URL(configPtr, myElem, format)
-					catch(err)
-					err = downloadFromURL(myURL, *delement+"."+format)
-					catch(err)
-					downloadChecksum(format)
+		warnSize(client.Config, *delement, format, parseSize(*dWarn))
+		if err = downloadFormat(ctx, client, format); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-				} else {
-					if !*fQuiet {
-						log.Printf("Checksum match, no download!")
-					}
-				}
-			} else {
-				myElem, err := findElem(configPtr, *delement)
-				catch(err)
-				myURL, err := elem2URL(configPtr, myElem, format)
-				catch(err)
-				err = downloadFromURL(myURL, *delement+"."+format)
-				catch(err)
-				if !downloadChecksum(format) && !*fQuiet {
-					log.Println("Checksum mismatch, please re-download", *delement+"."+format)
-				}
+func configExportCommand() error {
+	services := *ceServices
+	if len(services) == 0 {
+		for service, name := range serviceConfigs {
+			if _, ok := embeddedConfigs[name]; ok { // geofabrik-internal must be generated
+				services = append(services, service)
 			}
-		} else {
-			myElem, err := findElem(configPtr, *delement)
-			catch(err)
-			myURL, err := elem2URL(configPtr, myElem, format)
-			catch(err)
-			err = downloadFromURL(myURL, *delement+"."+format)
-			catch(err)
+		}
+		sort.Strings(services)
+	}
+	for _, service := range services {
+		filename, err := exportConfig(*ceDir, service, *ceForce)
+		if err != nil {
+			return err
+		}
+		if !*fQuiet {
+			log.Println("Config written to", filename)
 		}
 	}
+	return nil
 }
 
 func main() {
 
 	app.Version(version) // Add version flag
+	settings, err := loadSettings(settingsFile())
+	catch(err)
+	settings.setDefaults()
+	settingsAuth = settings.Auth
 	commands := kingpin.MustParse(app.Parse(os.Args[1:]))
+	setupLogFormat(os.Stderr)
 	checkService()
+	ctx, stop := handleSignals()
+	defer stop()
+	switch commands {
+	case list.FullCommand(), download.FullCommand(), validate.FullCommand():
+		catch(checkCache(ctx, *fConfig))
+	}
 	switch commands {
 	case list.FullCommand():
-		listCommand()
+		catch(listCommand())
 	case update.FullCommand():
-		err := UpdateConfig(*fURL, *fConfig)
+		err := UpdateConfig(ctx, *fURL, *fConfig)
 		catch(err)
 	case download.FullCommand():
-		downloadCommand()
+		catch(downloadCommand(ctx))
 	case generate.FullCommand():
-		Generate(*fConfig)
+		catch(Generate(ctx, *fConfig))
+	case diff.FullCommand():
+		differ, err := diffCommand(os.Stdout, *diffOld, *diffNew, *diffJSON)
+		catch(err)
+		if differ {
+			os.Exit(exitConfigsDiffer)
+		}
+	case configExport.FullCommand():
+		catch(configExportCommand())
+	case configConvert.FullCommand():
+		catch(convertConfig(*ccInput, *ccOutput))
+	case configMigrate.FullCommand():
+		configFile := *cmFile
+		if configFile == "" {
+			configFile = *fConfig
+		}
+		version, err := migrateCommand(configFile)
+		catch(err)
+		if !*fQuiet {
+			log.Printf("%s migrated from version %d to %d", configFile, version, geofabrik.ConfigVersion)
+		}
+	case configRefresh.FullCommand():
+		filename, err := refreshCache(ctx, *fService)
+		catch(err)
+		if !*fQuiet {
+			log.Println("Config cached in", filename)
+		}
+	case validate.FullCommand():
+		valid, err := validateCommand(os.Stdout, *fConfig, *vURLs, *vJobs)
+		catch(err)
+		if !valid {
+			os.Exit(exitInvalidConfig)
+		}
 	}
 
 }
@@ -197,85 +365,3 @@ func fileExist(filePath string) bool {
 	}
 	return false
 }
-
-func hashFileMD5(filePath string) (string, error) {
-	var returnMD5String string
-	if fileExist(filePath) {
-		file, err := os.Open(filePath)
-		if err != nil {
-			return returnMD5String, err
-		}
-		defer func() {
-			err := file.Close()
-			catch(err)
-		}()
-		hash := md5.New()
-
-		if _, err := io.Copy(hash, file); err != nil {
-			return returnMD5String, err
-		}
-		hashInBytes := hash.Sum(nil)[:16]
-		returnMD5String = hex.EncodeToString(hashInBytes)
-		return returnMD5String, nil
-	}
-	return returnMD5String, nil
-}
-
-func controlHash(hashfile string, hash string) (bool, error) {
-	if fileExist(hashfile) {
-		file, err := ioutil.ReadFile(hashfile)
-		if err != nil {
-			return false, err
-		}
-		filehash := strings.Split(string(file), " ")[0]
-		if *fVerbose && !*fQuiet {
-			log.Println("Hash from file :", filehash)
-		}
-		return strings.EqualFold(hash, filehash), nil
-	}
-	return false, nil
-}
-
-func downloadChecksum(format string) bool {
-	ret := false
-	if *dCheck {
-		hash := "md5"
-		fhash := format + "." + hash
-		configPtr, err := loadConfig(*fConfig)
-		catch(err)
-		if ok, _, _ := isHashable(configPtr, format); ok {
-			myElem, err := findElem(configPtr, *delement)
-			catch(err)
-			myURL, err := elem2URL(configPtr, myElem, fhash)
-			catch(err)
-			err = downloadFromURL(myURL, *delement+"."+fhash)
-			catch(err)
-			if *fVerbose && !*fQuiet {
-				log.Println("Hashing", *delement+"."+format)
-			}
-			hashed, err := hashFileMD5(*delement + "." + format)
-			if err != nil {
-				log.Panic(fmt.Errorf(err.Error()))
-			}
-			if *fVerbose && !*fQuiet {
-				log.Println("MD5 :", hashed)
-			}
-			ret, err := controlHash(*delement+"."+fhash, hashed)
-			if err != nil {
-				log.Panic(fmt.Errorf(err.Error()))
-			}
-			if !*fQuiet {
-				if ret {
-					log.Println("Checksum OK for", *delement+"."+format)
-				} else {
-					log.Println("Checksum MISMATCH for", *delement+"."+format)
-				}
-			}
-			return ret
-		}
-		if !*fQuiet {
-			log.Println("No checksum provided for", *delement+"."+format)
-		}
-	}
-	return ret
-}
@@ -1,13 +1,19 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"bou.ke/monkey"
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -63,146 +69,36 @@ func Test_catch(t *testing.T) {
 	type args struct {
 		err error
 	}
-	tests := []struct {
-		name string
-		args args
-		want string
-	}{
-		// TODO: Add test cases.
-		{name: "should display error", args: args{err: fmt.Errorf("test")}, want: "test"},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// For testing log, need to use Monkey patching
-			fakeLogFatal := func(msg ...interface{}) {
-				assert.Equal(t, tt.want, msg[0])
-			}
-			patch := monkey.Patch(log.Fatalln, fakeLogFatal)
-			defer patch.Unpatch()
-			catch(tt.args.err)
-		})
-	}
-}
-
-func Test_hashFileMD5(t *testing.T) {
-	type args struct {
-		filePath string
-	}
-	tests := []struct {
-		name    string
-		args    args
-		want    string
-		wantErr bool
-	}{
-		// TODO: Add test cases.
-		{name: "Check with LICENSE file", args: args{filePath: "./LICENSE"}, want: "65d26fcc2f35ea6a181ac777e42db1ea", wantErr: false},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := hashFileMD5(tt.args.filePath)
-			if err != nil != tt.wantErr {
-				t.Errorf("hashFileMD5(%v) error = %v, wantErr %v", tt.args.filePath, err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("hashFileMD5() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func Benchmark_hashFileMD5_LICENSE(b *testing.B) {
-	for n := 0; n < b.N; n++ {
-		hashFileMD5("./LICENSE")
-	}
-}
-func Benchmark_controlHash_LICENSE(b *testing.B) {
-	hash, _ := hashFileMD5("./LICENSE")
-	hashfile := "/tmp/download-geofabrik-test.hash"
-	ioutil.WriteFile(hashfile, []byte(hash), 0644)
-	for n := 0; n < b.N; n++ {
-		controlHash(hashfile, hash)
-	}
-}
-
-func Test_controlHash(t *testing.T) {
-	type args struct {
-		hashfile string
-		hash     string
-	}
-	tests := []struct {
-		name       string
-		args       args
-		want       bool
-		wantErr    bool
-		fileToHash string
-	}{
-		// TODO: Add test cases.
-		{name: "Check with LICENSE file", fileToHash: "./LICENSE", args: args{hashfile: "/tmp/download-geofabrik-test.hash", hash: "65d26fcc2f35ea6a181ac777e42db1ea"}, want: true, wantErr: false},
-		{name: "Check with LICENSE file wrong hash", fileToHash: "./LICENSE", args: args{hashfile: "/tmp/download-geofabrik-test.hash", hash: "65d26fcc2f35ea6a181ac777e42db1eb"}, want: false, wantErr: false},
-	}
-	for _, tt := range tests {
-		hash, _ := hashFileMD5(tt.fileToHash)
-		ioutil.WriteFile(tt.args.hashfile, []byte(hash), 0644)
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := controlHash(tt.args.hashfile, tt.args.hash)
-			if err != nil != tt.wantErr {
-				t.Errorf("controlHash() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("controlHash() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-// Test_downloadChecksum I don't know why sometimes controlHash fail :'(
-// seems geofabrik have a limit download I reach sometimes :/
-func Test_downloadChecksum(t *testing.T) {
-	*fQuiet = true // be silent!
-	type args struct {
-		format string
-	}
 	tests := []struct {
 		name     string
-		fConfig  string
-		dCheck   bool
-		delement string
 		args     args
-		want     bool
+		want     string
+		wantCode int
 	}{
 		// TODO: Add test cases.
-		{name: "dCheck = false monaco.osm.pbf from geofabrik", dCheck: false, fConfig: "./geofabrik.yml", delement: "monaco", args: args{format: "osm.pbf"}, want: false},
-		{name: "dCheck = true monaco.osm.pbf from geofabrik", fConfig: "./geofabrik.yml", dCheck: true, delement: "monaco", args: args{format: "osm.pbf"}, want: true},
-		{name: "dCheck = true monaco.poly from geofabrik", fConfig: "./geofabrik.yml", dCheck: true, delement: "monaco", args: args{format: "poly"}, want: false},
+		{name: "should display error", args: args{err: fmt.Errorf("test")}, want: "test\n", wantCode: exitError},
+		{name: "should display typed error", args: args{err: geofabrik.Errorf(geofabrik.ErrNetwork, "test")}, want: "test\n", wantCode: exitNetwork},
+		{name: "interrupted", args: args{err: context.Canceled}, want: "Interrupted\n", wantCode: exitInterrupted},
+		{name: "no error", args: args{err: nil}, want: "", wantCode: -1},
 	}
+	*fQuiet = false
 	for _, tt := range tests {
-		*dCheck = tt.dCheck
-		*fConfig = tt.fConfig
-		*delement = tt.delement
 		t.Run(tt.name, func(t *testing.T) {
-			if *dCheck { // If I want to compare checksum, Download file
-				configPtr, err := loadConfig(*fConfig)
-				if err != nil {
-					t.Error(err)
-				}
-				myElem, err := findElem(configPtr, *delement)
-				if err != nil {
-					t.Error(err)
-				}
-				myURL, err := elem2URL(configPtr, myElem, tt.args.format)
-				if err != nil {
-					t.Error(err)
-				}
-				downloadFromURL(myURL, *delement+"."+tt.args.format)
-			}
-			// now real test
-			if got := downloadChecksum(tt.args.format); got != tt.want {
-				t.Errorf("downloadChecksum() = %v, want %v", got, tt.want)
+			// For testing exit, need to use Monkey patching
+			code := -1
+			fakeExit := func(c int) {
+				code = c
 			}
-			os.Remove("monaco.osm.pbf")     // clean
-			os.Remove("monaco.osm.pbf.md5") // clean
+			patch := monkey.Patch(os.Exit, fakeExit)
+			defer patch.Unpatch()
+			var buf bytes.Buffer
+			log.SetOutput(&buf)
+			log.SetFlags(0)
+			defer log.SetOutput(os.Stderr)
+			defer log.SetFlags(log.LstdFlags)
+			catch(tt.args.err)
+			assert.Equal(t, tt.want, buf.String())
+			assert.Equal(t, tt.wantCode, code)
 		})
 	}
 }
@@ -225,12 +121,39 @@ func Test_listCommand(t *testing.T) {
 			}
 			patch := monkey.Patch(listAllRegions, fakelistAllRegions)
 			defer patch.Unpatch()
-			listCommand()
+			if err := listCommand(); err != nil {
+				t.Fatal(err)
+			}
 		})
 	}
 }
 
 func Test_downloadCommand(t *testing.T) {
+	configDir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(configDir)
+	internalConfig := filepath.Join(configDir, "geofabrik-internal.yml") // like generate write it
+	ioutil.WriteFile(internalConfig, []byte(`version: 1
+baseURL: https://osm-internal.download.geofabrik.de
+formats:
+  osh.pbf: {ext: osh.pbf, loc: -internal.osh.pbf}
+  osm.bz2: {ext: osm.bz2, loc: -latest.osm.bz2, baseurl: https://download.geofabrik.de}
+  osm.pbf: {ext: osm.pbf, loc: -latest-internal.osm.pbf}
+elements:
+  europe: {id: europe, name: Europe, files: [osm.pbf, osh.pbf, osm.bz2]}
+  monaco: {id: monaco, name: Monaco, files: [osm.pbf, osh.pbf, osm.bz2], parent: europe}
+`), 0644)
+	polyConfig := filepath.Join(configDir, "poly.yml") // no osm.pbf for monaco, and no format osm.pbf
+	ioutil.WriteFile(polyConfig, []byte(`baseURL: https://my.base.url
+formats:
+  kml: {ext: kml, loc: .kml}
+  osm.bz2: {ext: osm.bz2, loc: -latest.osm.bz2}
+  poly: {ext: poly, loc: .poly}
+elements:
+  monaco: {id: monaco, name: Monaco, files: [poly]}
+`), 0644)
 	type fFlags struct {
 		dosmPbf bool
 		doshPbf bool
@@ -247,10 +170,13 @@ func Test_downloadCommand(t *testing.T) {
 		dCheck        bool
 		delement      string
 		formatsFlags  fFlags
+		allFormats    bool
+		prefer        string
 		wantURL       string
 		wantOutput    string
 		checksumValid bool
 		fakefileExist bool
+		wantErr       error // kind of error
 	}{
 		// TODO: Add test cases.
 		{
@@ -302,6 +228,7 @@ func Test_downloadCommand(t *testing.T) {
 			wantOutput:    "monaco.osm.pbf",
 			fakefileExist: false,
 			checksumValid: false,
+			wantErr:       geofabrik.ErrChecksumMismatch,
 		},
 		{
 			name:     "monaco.osm.pbf from geofabrik.yml with Check file exist checksum mismatch",
@@ -315,6 +242,100 @@ func Test_downloadCommand(t *testing.T) {
 			wantOutput:    "monaco.osm.pbf",
 			fakefileExist: true,
 			checksumValid: false,
+			wantErr:       geofabrik.ErrChecksumMismatch,
+		},
+		{
+			name:     "monaco.osm.pbf from a generated geofabrik-internal.yml",
+			fConfig:  internalConfig,
+			delement: "monaco",
+			formatsFlags: fFlags{
+				dosmPbf: true,
+			},
+			wantURL:    "https://osm-internal.download.geofabrik.de/europe/monaco-latest-internal.osm.pbf",
+			wantOutput: "monaco.osm.pbf",
+		},
+		{
+			name:     "monaco.osh.pbf from a generated geofabrik-internal.yml",
+			fConfig:  internalConfig,
+			delement: "monaco",
+			formatsFlags: fFlags{
+				doshPbf: true,
+			},
+			wantURL:    "https://osm-internal.download.geofabrik.de/europe/monaco-internal.osh.pbf",
+			wantOutput: "monaco.osh.pbf",
+		},
+		{
+			name:     "monaco.osm.bz2 from a generated geofabrik-internal.yml",
+			fConfig:  internalConfig,
+			delement: "monaco",
+			formatsFlags: fFlags{
+				dosmBz2: true,
+			},
+			wantURL:    "https://download.geofabrik.de/europe/monaco-latest.osm.bz2",
+			wantOutput: "monaco.osm.bz2",
+		},
+		{
+			name:       "prefer with unknown formats",
+			fConfig:    "geofabrik.yml",
+			delement:   "monaco",
+			prefer:     "osm.o5m,osm.bz2,osm.pbf",
+			wantURL:    "https://download.geofabrik.de/europe/monaco-latest.osm.bz2",
+			wantOutput: "monaco.osm.bz2",
+		},
+		{
+			name:       "prefer only unknown formats",
+			fConfig:    "geofabrik.yml",
+			delement:   "monaco",
+			prefer:     "osm.o5m",
+			wantURL:    "https://download.geofabrik.de/europe/monaco-latest.osm.pbf",
+			wantOutput: "monaco.osm.pbf",
+		},
+		{
+			name:     "prefer ignored with a format flag",
+			fConfig:  "geofabrik.yml",
+			delement: "monaco",
+			formatsFlags: fFlags{
+				dosmPbf: true,
+			},
+			prefer:     "osm.bz2",
+			wantURL:    "https://download.geofabrik.de/europe/monaco-latest.osm.pbf",
+			wantOutput: "monaco.osm.pbf",
+		},
+		{
+			name:       "all formats without format osm.pbf",
+			fConfig:    polyConfig,
+			delement:   "monaco",
+			allFormats: true,
+			wantURL:    "https://my.base.url/monaco.poly",
+			wantOutput: "monaco.poly",
+		},
+		{
+			name:     "none of formats available",
+			fConfig:  polyConfig,
+			delement: "monaco",
+			formatsFlags: fFlags{
+				dkml:    true,
+				dosmBz2: true,
+			},
+			wantErr: geofabrik.ErrFormatUnavailable,
+		},
+		{
+			name:     "unknown element",
+			fConfig:  "geofabrik.yml",
+			delement: "nowhere",
+			formatsFlags: fFlags{
+				dosmPbf: true,
+			},
+			wantErr: geofabrik.ErrElementNotFound,
+		},
+		{
+			name:     "unknown format",
+			fConfig:  "geofabrik.yml",
+			delement: "monaco",
+			formatsFlags: fFlags{
+				doshPbf: true,
+			},
+			wantErr: geofabrik.ErrFormatUnavailable,
 		},
 	}
 	for _, tt := range tests {
@@ -328,26 +349,99 @@ func Test_downloadCommand(t *testing.T) {
 		*fConfig = tt.fConfig
 		*dCheck = tt.dCheck || false
 		*delement = tt.delement
+		*dPrefer = tt.prefer
+		*dAllFormats = tt.allFormats
 		t.Run(tt.name, func(t *testing.T) {
-			fakedownloadFromURL := func(myURL string, output string) error {
+			dir, err := ioutil.TempDir("", "download-geofabrik")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			*dOutputDir = dir
+			defer func() { *dOutputDir = "" }()
+			if tt.fakefileExist {
+				ioutil.WriteFile(filepath.Join(dir, tt.wantOutput), []byte("fake"), 0644)
+			}
+			fakeDownloadFile := func(_ *geofabrik.Client, _ context.Context, myURL string, output string, _ geofabrik.DownloadOptions) error {
 				assert.Equal(t, tt.wantURL, myURL)
-				assert.Equal(t, tt.wantOutput, output)
+				assert.Equal(t, filepath.Join(dir, tt.wantOutput), output)
 				return nil
 			}
-			fakedownloadChecksum := func(f string) bool {
-				return tt.checksumValid
-			}
-			fakefileExist := func(f string) bool {
-				return tt.fakefileExist
+			fakeVerify := func(_ *geofabrik.Client, _ context.Context, element string, format string, _ geofabrik.DownloadOptions) (bool, error) {
+				return tt.checksumValid, nil
 			}
-			patch := monkey.Patch(downloadFromURL, fakedownloadFromURL)
-			patch2 := monkey.Patch(downloadChecksum, fakedownloadChecksum)
-			patch3 := monkey.Patch(fileExist, fakefileExist)
+			client := reflect.TypeOf(&geofabrik.Client{})
+			patch := monkey.PatchInstanceMethod(client, "DownloadFile", fakeDownloadFile)
+			patch2 := monkey.PatchInstanceMethod(client, "Verify", fakeVerify)
 			defer patch.Unpatch()
 			defer patch2.Unpatch()
-			defer patch3.Unpatch()
-			downloadCommand()
+			if err := downloadCommand(context.Background()); geofabrik.Cause(err) != tt.wantErr {
+				t.Errorf("downloadCommand() error = %v, wantErr %v", err, tt.wantErr)
+			}
 			//			izefnof // real error should not compile
 		})
 	}
+	*dPrefer = ""
+	*dAllFormats = false
+}
+
+func Test_warnSize(t *testing.T) {
+	c := &Config{
+		Formats: map[string]format{"osm.pbf": {ID: "osm.pbf"}, "poly": {ID: "poly"}},
+		Elements: map[string]Element{
+			"monaco": {ID: "monaco", Formats: []string{"osm.pbf", "poly"}, Info: map[string]FileInfo{"osm.pbf": {Size: 2 << 30}}},
+		},
+	}
+	tests := []struct {
+		name    string
+		element string
+		format  string
+		limit   int64
+		verbose bool
+		want    string
+	}{
+		{name: "bigger than limit", element: "monaco", format: "osm.pbf", limit: 1 << 30, want: "Warning: monaco.osm.pbf is 2.0 GB"},
+		{name: "smaller than limit", element: "monaco", format: "osm.pbf", limit: 4 << 30, want: ""},
+		{name: "smaller than limit verbose", element: "monaco", format: "osm.pbf", limit: 4 << 30, verbose: true, want: "monaco.osm.pbf is 2.0 GB"},
+		{name: "no limit", element: "monaco", format: "osm.pbf", limit: 0, want: ""},
+		{name: "no info", element: "monaco", format: "poly", limit: 1, want: ""},
+		{name: "not in config", element: "nowhere", format: "osm.pbf", limit: 1, want: ""},
+	}
+	*fQuiet = false
+	for _, tt := range tests {
+		*fVerbose = tt.verbose
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			log.SetOutput(&buf)
+			log.SetFlags(0)
+			defer log.SetOutput(os.Stderr)
+			defer log.SetFlags(log.LstdFlags)
+			warnSize(c, tt.element, tt.format, tt.limit)
+			assert.Equal(t, tt.want, strings.TrimSpace(buf.String()))
+		})
+	}
+	*fVerbose = false
+}
+
+func Test_localFile(t *testing.T) {
+	tests := []struct {
+		name      string
+		outputDir string
+		element   string
+		format    string
+		want      string
+	}{
+		{name: "current dir", outputDir: "", element: "monaco", format: "osm.pbf", want: "monaco.osm.pbf"},
+		{name: "output dir", outputDir: "/data/osm", element: "monaco", format: "osm.pbf.md5", want: "/data/osm/monaco.osm.pbf.md5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*dOutputDir = tt.outputDir
+			*delement = tt.element
+			defer func() { *dOutputDir = "" }()
+			if got := localFile(tt.format); got != tt.want {
+				t.Errorf("localFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
 }
@@ -1,106 +1,97 @@
 package main
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
-	"net/url"
 	"os"
 	"time"
 
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
 	pb "gopkg.in/cheggaaa/pb.v1"
-
-	"golang.org/x/net/proxy"
 )
 
 const progressMinimal = 512 * 1024 // Don't display progress bar if size < 512kb
 
-func downloadFromURL(myURL string, fileName string) error {
-	if *fVerbose && !*fQuiet {
-		log.Println("Downloading", myURL, "to", fileName)
+// newProgress give a progress bar of size bytes if --progress is used.
+// Small files have no progress bar.
+func newProgress(size int64) geofabrik.Progress {
+	if *fQuiet || !*fProgress || size <= progressMinimal {
+		return nil
 	}
+	progressBar := pb.New64(size)
+	progressBar.SetUnits(pb.U_BYTES)
+	progressBar.ShowTimeLeft = true
+	progressBar.ShowSpeed = true
+	progressBar.RefreshRate = time.Millisecond * 100 // reduce cpu usage, 100 seems to be a good value
+	progressBar.Start()
+	return progressBar
+}
 
-	if !*fNodownload {
+// rateLimiter is shared by all downloads, see --limit-rate.
+var rateLimiter *geofabrik.Limiter
 
-		transport := &http.Transport{}
-		if *fProxyHTTP != "" {
-			u, _ := url.Parse(myURL)
-			//log.Println(u.Scheme +"://"+ *fProxyHTTP)
-			proxyURL, err := url.Parse(u.Scheme + "://" + *fProxyHTTP)
-			if *fProxyUser != "" && *fProxyPass != "" {
-				proxyURL, err = url.Parse(u.Scheme + "://" + *fProxyUser + ":" + *fProxyPass + *fProxyHTTP)
-			}
-			if err != nil {
-				return fmt.Errorf("Wrong proxy url, please use format proxy_address:port")
-			}
-			transport = &http.Transport{Proxy: http.ProxyURL(proxyURL)}
-		}
-		client := &http.Client{Transport: transport}
-		if *fProxySock5 != "" {
-			auth := proxy.Auth{User: *fProxyUser, Password: *fProxyPass}
-			dialer, err := proxy.SOCKS5("tcp", *fProxySock5, &auth, proxy.Direct)
-			if err != nil {
-				return fmt.Errorf("Can't connect to the proxy: %v", err)
-			}
-			transport.Dial = dialer.Dial
-		}
-		response, err := client.Get(myURL)
-		if err != nil {
-			return fmt.Errorf("Error while downloading %s - %v", myURL, err)
-		}
-		if response.StatusCode != 200 {
-			if response.StatusCode == 404 {
-				return fmt.Errorf("Error while downloading %v, server return code %d\nPlease use 'download-geofabrik generate' to re-create your yml file", myURL, response.StatusCode)
-			}
-			return fmt.Errorf("Error while downloading %v, server return code %d", myURL, response.StatusCode)
-		}
-		defer func() {
-			err := response.Body.Close()
-			catch(err)
-		}()
+// limiter give rateLimiter, nil if --limit-rate is 0.
+func limiter() (*geofabrik.Limiter, error) {
+	if rateLimiter != nil || *fLimitRate == "" || *fLimitRate == "0" {
+		return rateLimiter, nil
+	}
+	rate := parseSize(*fLimitRate)
+	if rate <= 0 {
+		return nil, fmt.Errorf("Wrong rate %s, please use format like 500K or 5M", *fLimitRate)
+	}
+	rateLimiter = geofabrik.NewLimiter(rate)
+	return rateLimiter, nil
+}
 
-		// If no error, create file
-		// TODO: check file existence first with io.IsExist
-		// and use a new flag (like f) to force overwrite
-		flags := os.O_CREATE | os.O_WRONLY
-		var f *os.File
-		f, err = os.OpenFile(fileName, flags, 0666)
-		if err != nil {
-			return fmt.Errorf("Error while creating %s - %v", fileName, err)
+// downloadClient make a library client using flags and credentials of c.
+func downloadClient(c *Config) (*geofabrik.Client, error) {
+	auths := clientAuths(c)
+	if c != nil {
+		if err := checkCookies(auths); err != nil {
+			return nil, err
 		}
-		defer func() {
-			err := f.Close()
-			catch(err)
-		}()
-		var output io.Writer
-		output = f
-		var n int64
-		var progressBar *pb.ProgressBar
-		if !*fQuiet && *fProgress && response.ContentLength > progressMinimal {
+	}
+	httpClient, err := newClient(auths)
+	if err != nil {
+		return nil, err
+	}
+	client := configClient()
+	client.HTTPClient = httpClient
+	if client.Limiter, err = limiter(); err != nil {
+		return nil, err
+	}
+	if events != nil {
+		client.Events = events.Event
+	}
+	if events != nil && !*fQuiet {
+		client.Logger = log.New(events, "", 0)
+	} else if !*fQuiet {
+		client.Logger = log.New(os.Stderr, log.Prefix(), log.Flags())
+	}
+	client.Verbose = *fVerbose
+	return client, nil
+}
 
-			progressBar = pb.New64(response.ContentLength)
-			progressBar.SetUnits(pb.U_BYTES)
-			progressBar.ShowTimeLeft = true
-			progressBar.ShowSpeed = true
-			progressBar.RefreshRate = time.Millisecond * 100 // reduce cpu usage, 100 seems to be a good value
-			progressBar.Start()
-			defer progressBar.Finish()
-			output = io.MultiWriter(output, progressBar)
-		}
-		n, err = io.Copy(output, response.Body)
-		if err != nil {
-			return fmt.Errorf("Error while writing %s - %v", fileName, err)
-		}
-		if !*fQuiet {
-			if progressBar != nil {
-				progressBar.Finish() // Force finish
-			}
-			log.Println(fileName, "downloaded.")
-			if *fVerbose {
-				log.Println(n, "bytes downloaded.")
-			}
-		}
+// downloadOptions give library options from download flags.
+func downloadOptions() geofabrik.DownloadOptions {
+	return geofabrik.DownloadOptions{
+		OutputDir: *dOutputDir,
+		Check:     *dCheck,
+		DryRun:    *fNodownload,
+		Resume:    *dResume,
+		Segments:  *dSegments,
+		Progress:  newProgress,
+	}
+}
+
+func downloadFromURL(ctx context.Context, myURL string, fileName string) error {
+	client, err := downloadClient(nil)
+	if err != nil {
+		return err
 	}
-	return nil // Everything is ok
+	return client.DownloadFile(ctx, myURL, fileName, geofabrik.DownloadOptions{
+		DryRun:   *fNodownload,
+		Progress: newProgress,
+	})
 }
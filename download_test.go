@@ -1,6 +1,9 @@
 package main
 
-import "testing"
+import (
+	"context"
+	"testing"
+)
 
 func Test_downloadFromURL(t *testing.T) {
 	type args struct {
@@ -54,9 +57,58 @@ func Test_downloadFromURL(t *testing.T) {
 		*fQuiet = tt.fQuiet
 		*fProgress = tt.fProgress
 		t.Run(tt.name, func(t *testing.T) {
-			if err := downloadFromURL(tt.args.myURL, tt.args.fileName); err != nil != tt.wantErr {
+			if err := downloadFromURL(context.Background(), tt.args.myURL, tt.args.fileName); err != nil != tt.wantErr {
 				t.Errorf("downloadFromURL() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
+
+func Test_limiter(t *testing.T) {
+	tests := []struct {
+		name       string
+		fLimitRate string
+		wantNil    bool
+		wantErr    bool
+	}{
+		{name: "no limit", fLimitRate: "0", wantNil: true},
+		{name: "empty", fLimitRate: "", wantNil: true},
+		{name: "5M", fLimitRate: "5M"},
+		{name: "wrong", fLimitRate: "fast", wantNil: true, wantErr: true},
+	}
+	defer func() {
+		*fLimitRate = "0"
+		rateLimiter = nil
+	}()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rateLimiter = nil
+			*fLimitRate = tt.fLimitRate
+			got, err := limiter()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("limiter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil) != tt.wantNil {
+				t.Errorf("limiter() = %v, want nil: %v", got, tt.wantNil)
+			}
+			if again, _ := limiter(); again != got {
+				t.Errorf("limiter() must give the same Limiter to every download")
+			}
+		})
+	}
+}
+
+func Test_downloadOptions(t *testing.T) {
+	*dOutputDir = "/tmp/osm"
+	*dResume = true
+	*dSegments = 4
+	defer func() {
+		*dOutputDir = ""
+		*dResume = false
+		*dSegments = 1
+	}()
+	got := downloadOptions()
+	if got.OutputDir != "/tmp/osm" || !got.Resume || got.Segments != 4 || got.Progress == nil {
+		t.Errorf("downloadOptions() = %+v, want flags values", got)
+	}
+}
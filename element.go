@@ -2,90 +2,61 @@ package main
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
-)
 
-type Element struct {
-	ID      string   `yaml:"id"`
-	File    string   `yaml:"file,omitempty"`
-	Meta    bool     `yaml:"meta,omitempty"`
-	Name    string   `yaml:"name,omitempty"`
-	Formats []string `yaml:"files,omitempty"`
-	Parent  string   `yaml:"parent,omitempty"`
-}
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
+)
 
-func (e *Element) hasParent() bool {
-	return len(e.Parent) != 0
-}
+// Element and FileInfo come from the library.
+type (
+	Element  = geofabrik.Element
+	FileInfo = geofabrik.FileInfo
+)
 
-func elem2URL(c *Config, e *Element, ext string) (string, error) {
-	var res string
-	var err error
-	if !stringInSlice(&ext, &e.Formats) {
-		return "", fmt.Errorf("Error!!! %s format not exist", ext)
+// parseSize convert sizes like "61.4 MB", "3.9M" or "107" in bytes.
+// Units are powers of 1024, return 0 if s is not a size.
+func parseSize(s string) int64 {
+	s = strings.ToUpper(strings.TrimSpace(s)) // like mb or MiB
+	s = strings.TrimSuffix(strings.TrimSuffix(s, "B"), "I")
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
 	}
-	format := c.Formats[ext]
-	if format.BasePath != "" {
-		if format.BaseURL != "" {
-			res, err = elem2preURL(c, e, format.BaseURL, format.BasePath)
-		} else {
-			res, err = elem2preURL(c, e, format.BasePath)
-		}
-	} else {
-		if format.BaseURL != "" {
-			res, err = elem2preURL(c, e, format.BaseURL, "")
-		} else {
-			res, err = elem2preURL(c, e)
-		}
+	multiplier := 1.0
+	switch s[len(s)-1] {
+	case 'K':
+		multiplier = 1 << 10
+	case 'M':
+		multiplier = 1 << 20
+	case 'G':
+		multiplier = 1 << 30
+	case 'T':
+		multiplier = 1 << 40
 	}
-	// TODO check if valid URL
-	if err != nil {
-		return "", err
+	if multiplier != 1 {
+		s = strings.TrimSpace(s[:len(s)-1])
 	}
-	res += format.Loc
-	return res, nil
-}
-
-func elem2preURL(c *Config, e *Element, b ...string) (string, error) {
-	var res string
-	myElem, err := findElem(c, e.ID)
-	if err != nil {
-		return "", err
-	}
-	if myElem.hasParent() {
-		parent, err := findElem(c, myElem.Parent)
-		if err != nil {
-			return "", err
-		}
-		res, err = elem2preURL(c, parent, b...)
-		if err != nil {
-			return "", err
-		}
-		res = res + "/"
-		if myElem.File != "" { //TODO use file in config???
-			res = res + myElem.File
-		} else {
-			res = res + myElem.ID
-		}
-		return res, nil
-	}
-	switch len(b) {
-	case 1:
-		return c.BaseURL + "/" + strings.Join(b, "/") + myElem.ID, nil
-	case 2:
-		return strings.Join(b, "/") + myElem.ID, nil
-	default:
-		return c.BaseURL + "/" + myElem.ID, nil
+	size, err := strconv.ParseFloat(s, 64)
+	if err != nil || size < 0 {
+		return 0
 	}
+	return int64(size * multiplier)
 }
 
-func findElem(c *Config, e string) (*Element, error) {
-	res := c.Elements[e]
-	//fmt.Println("findElem", res.ID, e)
-	if res.ID == "" || res.ID != e {
-		return nil, fmt.Errorf("%s is not in config\n Please use \"list\" command", e)
+// humanSize is the reverse of parseSize.
+func humanSize(size int64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB"}
+	value := float64(size)
+	i := 0
+	for value >= 1024 && i < len(units)-1 {
+		value /= 1024
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%d B", size)
 	}
-	return &res, nil
+	return fmt.Sprintf("%.1f %s", value, units[i])
 }
 
 // stringInSlice : Check if a sting is present in a slice
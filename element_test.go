@@ -1,7 +1,6 @@
 package main
 
 import (
-	"reflect"
 	"testing"
 )
 
@@ -104,138 +103,6 @@ var sampleFakeGeorgia2Ptr = Element{
 	Parent: "notus", // bad parent not exist!
 }
 
-func Benchmark_hasParent_parse_geofabrik_yml(b *testing.B) {
-	c, _ := loadConfig("./geofabrik.yml")
-	for n := 0; n < b.N; n++ {
-		for _, v := range c.Elements {
-			v.hasParent()
-		}
-	}
-}
-
-func TestElement_hasParent(t *testing.T) {
-
-	tests := []struct {
-		name   string
-		fields Element
-		want   bool
-	}{
-		// TODO: Add test cases.
-		{
-			name:   "us Have parent",
-			fields: sampleElementValidPtr["us"],
-			want:   true,
-		}, {
-			name:   "Africa Haven't parent",
-			fields: sampleElementValidPtr["Africa"],
-			want:   false,
-		},
-		{
-			name:   "Haven't parent 2",
-			fields: Element{ID: "", File: "", Meta: true, Name: "", Formats: *new([]string), Parent: ""},
-			want:   false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			e := &Element{
-				ID:      tt.fields.ID,
-				File:    tt.fields.File,
-				Meta:    tt.fields.Meta,
-				Name:    tt.fields.Name,
-				Formats: tt.fields.Formats,
-				Parent:  tt.fields.Parent,
-			}
-			if got := e.hasParent(); got != tt.want {
-				t.Errorf("Element.hasParent() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func Benchmark_findElem_parse_all_geofabrik_yml(b *testing.B) {
-	c, _ := loadConfig("./geofabrik.yml")
-	for n := 0; n < b.N; n++ {
-		for k := range c.Elements {
-			findElem(c, k)
-		}
-	}
-}
-func Benchmark_findElem_parse_France_geofabrik_yml(b *testing.B) {
-	c, _ := loadConfig("./geofabrik.yml")
-	for n := 0; n < b.N; n++ {
-		findElem(c, "france")
-	}
-}
-
-func Test_findElem(t *testing.T) {
-	type args struct {
-		c *Config
-		e string
-	}
-	tests := []struct {
-		name    string
-		args    args
-		want    *Element
-		wantErr bool
-	}{
-		// TODO: Add test cases.
-		{
-			name: "Find",
-			args: args{
-				c: &SampleConfigValidPtr,
-				e: "africa",
-			},
-			want: &Element{
-				ID:   "africa",
-				Name: "Africa",
-				Formats: []string{
-					"osm.pbf",
-					"osm.pbf.md5",
-					"osm.bz2",
-					"osm.bz2.md5",
-					"osh.pbf",
-					"osh.pbf.md5",
-					"poly",
-					"kml",
-					"state",
-				},
-			},
-			wantErr: false,
-		},
-		{
-			name: "Cant find notInList",
-			args: args{
-				c: &SampleConfigValidPtr,
-				e: "notInList",
-			},
-			want:    nil,
-			wantErr: true,
-		},
-		{
-			name: "Should not find",
-			args: args{
-				c: &SampleConfigValidPtr,
-				e: "",
-			},
-			want:    nil,
-			wantErr: true,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := findElem(tt.args.c, tt.args.e)
-			if err != nil != tt.wantErr {
-				t.Errorf("findElem() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("findElem() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
 func Benchmark_stringInSlice_parse_geofabrik_yml(b *testing.B) {
 	c, _ := loadConfig("./geofabrik.yml")
 	sliceE := []string{}
@@ -289,168 +156,52 @@ func Test_stringInSlice(t *testing.T) {
 	}
 }
 
-func Test_elem2URL(t *testing.T) {
-	localSampleConfigValidPtr := SampleConfigValidPtr
-	localSampleConfigValidPtr.Elements["georgia-us2"] = sampleFakeGeorgiaPtr // add it into config
-	type args struct {
-		c   *Config
-		e   *Element
-		ext string
-	}
+func Test_parseSize(t *testing.T) {
 	tests := []struct {
-		name    string
-		args    args
-		want    string
-		wantErr bool
+		s    string
+		want int64
 	}{
-		// TODO: Add test cases.
-		{
-			name:    "top level test config",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleAfricaElementPtr, ext: "osm.pbf"},
-			want:    "https://my.base.url/africa.osm.pbf",
-			wantErr: false,
-		}, {
-			name:    "sub level test config",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleGeorgiaUsElementPtr, ext: "state"},
-			want:    "https://my.base.url/../state/north-america/us/georgia-updates/state.txt",
-			wantErr: false,
-		}, {
-			name:    "BaseUrl test config",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleGeorgiaUsElementPtr, ext: "poly"},
-			want:    "http://my.new.url/folder/north-america/us/georgia.poly",
-			wantErr: false,
-		}, {
-			name:    "BaseUrl + BasePath test config",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleGeorgiaUsElementPtr, ext: "osm.bz2"},
-			want:    "http://my.new.url/folder/../osmbz2/north-america/us/georgia.osm.bz2",
-			wantErr: false,
-		},
-		{
-			name:    "sub level test config wrong format",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleGeorgiaUsElementPtr, ext: "wrongFmt"},
-			want:    "",
-			wantErr: true,
-		},
-		{
-			name:    "sub level test config not exists in config",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleFakeGeorgiaPtr, ext: "state"},
-			want:    "",
-			wantErr: true,
-		},
-		{
-			name:    "sub level test config not exists parent",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleFakeGeorgia2Ptr, ext: "state"},
-			want:    "",
-			wantErr: true,
-		},
+		{s: "107", want: 107},
+		{s: "107 ", want: 107},
+		{s: " 4.1K", want: 4198},
+		{s: "3.9M", want: 4089446},
+		{s: "12G", want: 12 << 30},
+		{s: "61.4 MB", want: 64382566},
+		{s: "2 GiB", want: 2 << 30},
+		{s: "1 TB", want: 1 << 40},
+		{s: "2 MiB", want: 2 << 20},
+		{s: "2 mb", want: 2 << 20},
+		{s: "4.1k", want: 4198},
+		{s: " - ", want: 0},
+		{s: "", want: 0},
+		{s: "21 МБ", want: 0},
 	}
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := elem2URL(tt.args.c, tt.args.e, tt.args.ext)
-			if err != nil != tt.wantErr {
-				t.Errorf("elem2URL() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("elem2URL() = %v, want %v", got, tt.want)
+		t.Run(tt.s, func(t *testing.T) {
+			if got := parseSize(tt.s); got != tt.want {
+				t.Errorf("parseSize(%q) = %v, want %v", tt.s, got, tt.want)
 			}
 		})
 	}
 }
 
-func Test_elem2preURL(t *testing.T) {
-	localSampleConfigValidPtr := SampleConfigValidPtr
-	localSampleConfigValidPtr.Elements["georgia-us2"] = sampleFakeGeorgia2Ptr // add it into config
-	type args struct {
-		c *Config
-		e *Element
-		b []string
-	}
+func Test_humanSize(t *testing.T) {
 	tests := []struct {
-		name    string
-		args    args
-		want    string
-		wantErr bool
+		size int64
+		want string
 	}{
-		// TODO: Add test cases.
-		{
-			name:    "top level test config",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleAfricaElementPtr},
-			want:    "https://my.base.url/africa",
-			wantErr: false,
-		}, {
-			name:    "top level test config with basePath base/",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleAfricaElementPtr, b: []string{"base/"}},
-			want:    "https://my.base.url/base/africa",
-			wantErr: false,
-		}, {
-			name:    "sub level test config",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleGeorgiaUsElementPtr},
-			want:    "https://my.base.url/north-america/us/georgia",
-			wantErr: false,
-		}, {
-			name:    "sub level test config not exists in config",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleFakeGeorgiaPtr},
-			want:    "",
-			wantErr: true,
-		},
-		{
-			name:    "sub level test config not exists parent",
-			args:    args{c: &localSampleConfigValidPtr, e: &sampleFakeGeorgia2Ptr},
-			want:    "",
-			wantErr: true,
-		},
+		{size: 0, want: "0 B"},
+		{size: 107, want: "107 B"},
+		{size: 4198, want: "4.1 KB"},
+		{size: 64382566, want: "61.4 MB"},
+		{size: 12 << 30, want: "12.0 GB"},
+		{size: 3 << 40, want: "3.0 TB"},
 	}
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := elem2preURL(tt.args.c, tt.args.e, tt.args.b...)
-			if err != nil != tt.wantErr {
-				t.Errorf("elem2preURL() =%v error = %v, wantErr %v", got, err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("elem2preURL() = %v, want %v", got, tt.want)
+		t.Run(tt.want, func(t *testing.T) {
+			if got := humanSize(tt.size); got != tt.want {
+				t.Errorf("humanSize(%v) = %v, want %v", tt.size, got, tt.want)
 			}
 		})
 	}
 }
-func Benchmark_elem2preURL_parse_France_geofabrik_yml(b *testing.B) {
-	c, err := loadConfig("./geofabrik.yml")
-	if err != nil {
-		b.Errorf(err.Error())
-	}
-	france, err := findElem(c, "france")
-	if err != nil {
-		b.Errorf(err.Error())
-	}
-	for n := 0; n < b.N; n++ {
-		elem2preURL(c, france)
-	}
-}
-func Benchmark_elem2URL_parse_France_geofabrik_yml(b *testing.B) {
-	c, err := loadConfig("./geofabrik.yml")
-	if err != nil {
-		b.Errorf(err.Error())
-	}
-	france, err := findElem(c, "france")
-	if err != nil {
-		b.Errorf(err.Error())
-	}
-	for n := 0; n < b.N; n++ {
-		elem2URL(c, france, "state")
-	}
-}
-
-func Benchmark_elem2URL_parse_France_openstreetmap_fr_yml(b *testing.B) {
-	c, err := loadConfig("./openstreetmap.fr.yml")
-	if err != nil {
-		b.Errorf(err.Error())
-	}
-	france, err := findElem(c, "france")
-	if err != nil {
-		b.Errorf(err.Error())
-	}
-	for n := 0; n < b.N; n++ {
-		elem2URL(c, france, "poly")
-	}
-}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
+)
+
+// eventLog is a log event, for lines written with the log package.
+const eventLog = "log"
+
+// jsonEvent is a line written with --log-format json.
+type jsonEvent struct {
+	Time     time.Time `json:"time"`
+	Event    string    `json:"event"`
+	Element  string    `json:"element,omitempty"`
+	Format   string    `json:"format,omitempty"`
+	URL      string    `json:"url,omitempty"`
+	Bytes    int64     `json:"bytes"`
+	Duration float64   `json:"duration"` // in seconds
+	Message  string    `json:"message,omitempty"`
+}
+
+// eventWriter write one JSON event by line in w.
+type eventWriter struct {
+	mu        sync.Mutex
+	w         io.Writer
+	lastError error // last error event, to not send it twice
+}
+
+// events is used by --log-format json, nil for text logs.
+var events *eventWriter
+
+// setupLogFormat use JSON events for logs if asked.
+// Progress bars are disabled with JSON events.
+func setupLogFormat(w io.Writer) {
+	if *fLogFormat != "json" {
+		return
+	}
+	events = &eventWriter{w: w}
+	log.SetOutput(events)
+	log.SetFlags(0)
+	*fProgress = false
+}
+
+func (ew *eventWriter) write(e jsonEvent) {
+	ew.mu.Lock()
+	defer ew.mu.Unlock()
+	e.Time = time.Now().UTC()
+	line, _ := json.Marshal(e)
+	ew.w.Write(append(line, '\n'))
+}
+
+// Event write a library event.
+func (ew *eventWriter) Event(e geofabrik.Event) {
+	event := jsonEvent{
+		Event:    e.Type,
+		Element:  e.Element,
+		Format:   e.Format,
+		URL:      e.URL,
+		Bytes:    e.Bytes,
+		Duration: e.Duration.Seconds(),
+	}
+	if e.Err != nil {
+		event.Message = e.Err.Error()
+		ew.mu.Lock()
+		ew.lastError = e.Err
+		ew.mu.Unlock()
+	}
+	ew.write(event)
+}
+
+// Error write an error event, unless err was already sent by the library.
+func (ew *eventWriter) Error(err error) {
+	ew.mu.Lock()
+	sent := err == ew.lastError
+	ew.mu.Unlock()
+	if !sent {
+		ew.write(jsonEvent{Event: geofabrik.EventError, Message: err.Error()})
+	}
+}
+
+// Write make a log event of each line, it's the output of the log package.
+func (ew *eventWriter) Write(p []byte) (int, error) {
+	for _, line := range bytes.Split(bytes.TrimRight(p, "\n"), []byte("\n")) {
+		ew.write(jsonEvent{Event: eventLog, Message: string(line)})
+	}
+	return len(p), nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
+)
+
+// readEvents decode JSON events written in buf.
+func readEvents(t *testing.T, buf *bytes.Buffer) []jsonEvent {
+	var res []jsonEvent
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var e jsonEvent
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			t.Fatalf("can't decode event %q: %v", line, err)
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+func Test_eventWriter(t *testing.T) {
+	var buf bytes.Buffer
+	ew := &eventWriter{w: &buf}
+	err := geofabrik.Errorf(geofabrik.ErrHTTPStatus, "server return code 500")
+	ew.Event(geofabrik.Event{Type: geofabrik.EventDownloadFinished, Element: "monaco", Format: "osm.pbf", URL: "https://download.geofabrik.de/europe/monaco-latest.osm.pbf", Bytes: 42, Duration: 1500 * time.Millisecond})
+	ew.Event(geofabrik.Event{Type: geofabrik.EventError, Element: "monaco", Format: "osm.pbf", Err: err})
+	ew.Error(err) // already sent
+	ew.Error(fmt.Errorf("oops"))
+	fmt.Fprintln(ew, "first line\nsecond line")
+	want := []jsonEvent{
+		{Event: geofabrik.EventDownloadFinished, Element: "monaco", Format: "osm.pbf", URL: "https://download.geofabrik.de/europe/monaco-latest.osm.pbf", Bytes: 42, Duration: 1.5},
+		{Event: geofabrik.EventError, Element: "monaco", Format: "osm.pbf", Message: "server return code 500"},
+		{Event: geofabrik.EventError, Message: "oops"},
+		{Event: eventLog, Message: "first line"},
+		{Event: eventLog, Message: "second line"},
+	}
+	got := readEvents(t, &buf)
+	if len(got) != len(want) {
+		t.Fatalf("eventWriter write %d events, want %d:\n%s", len(got), len(want), buf.String())
+	}
+	for i := range want {
+		if got[i].Time.IsZero() {
+			t.Errorf("event %d have no time", i)
+		}
+		got[i].Time = time.Time{}
+		if got[i] != want[i] {
+			t.Errorf("event %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func Test_setupLogFormat(t *testing.T) {
+	defer func() {
+		events = nil
+		*fLogFormat = "text"
+		log.SetOutput(os.Stderr)
+		log.SetFlags(log.LstdFlags)
+	}()
+	var buf bytes.Buffer
+	*fLogFormat = "text"
+	setupLogFormat(&buf)
+	if events != nil {
+		t.Errorf("setupLogFormat() use events for text logs")
+	}
+	*fLogFormat = "json"
+	*fProgress = true
+	setupLogFormat(&buf)
+	if events == nil || *fProgress {
+		t.Fatalf("setupLogFormat() must use events and disable progress bars for json logs")
+	}
+	log.Println("hello")
+	if got := readEvents(t, &buf); len(got) != 1 || got[0].Event != eventLog || got[0].Message != "hello" {
+		t.Errorf("log.Println() write %q, want a log event", buf.String())
+	}
+}
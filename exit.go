@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
+)
+
+// Exit codes of download-geofabrik, documented in README.
+const (
+	exitError             = 1 // any other error
+	exitElementNotFound   = 2
+	exitFormatUnavailable = 3
+	exitChecksumMismatch  = 4
+	exitHTTPStatus        = 5
+	exitNetwork           = 6
+	exitConfigsDiffer     = 7   // diff found differences
+	exitInvalidConfig     = 8   // validate found errors
+	exitInterrupted       = 130 // stopped by SIGINT or SIGTERM, like shells do for SIGINT
+)
+
+// exitCodes give the exit code of each kind of error.
+var exitCodes = map[error]int{
+	geofabrik.ErrElementNotFound:   exitElementNotFound,
+	geofabrik.ErrFormatUnavailable: exitFormatUnavailable,
+	geofabrik.ErrChecksumMismatch:  exitChecksumMismatch,
+	geofabrik.ErrHTTPStatus:        exitHTTPStatus,
+	geofabrik.ErrNetwork:           exitNetwork,
+	context.Canceled:               exitInterrupted,
+}
+
+// exitCode give the exit code for err, 0 if err is nil.
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	if code, ok := exitCodes[geofabrik.Cause(err)]; ok {
+		return code
+	}
+	return exitError
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
+)
+
+func Test_exitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "no error", err: nil, want: 0},
+		{name: "other error", err: fmt.Errorf("oops"), want: exitError},
+		{name: "element not found", err: geofabrik.Errorf(geofabrik.ErrElementNotFound, "oops"), want: exitElementNotFound},
+		{name: "format unavailable", err: geofabrik.Errorf(geofabrik.ErrFormatUnavailable, "oops"), want: exitFormatUnavailable},
+		{name: "checksum mismatch", err: geofabrik.Errorf(geofabrik.ErrChecksumMismatch, "oops"), want: exitChecksumMismatch},
+		{name: "http status", err: geofabrik.Errorf(geofabrik.ErrHTTPStatus, "oops"), want: exitHTTPStatus},
+		{name: "404", err: geofabrik.NotFoundError{URL: "https://download.geofabrik.de/europe/monaco-latest.osm.pbf"}, want: exitHTTPStatus},
+		{name: "network", err: geofabrik.Errorf(geofabrik.ErrNetwork, "oops"), want: exitNetwork},
+		{name: "interrupted", err: context.Canceled, want: exitInterrupted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCode(tt.err); got != tt.want {
+				t.Errorf("exitCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
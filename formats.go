@@ -1,54 +1,149 @@
 package main
 
 import (
+	"log"
 	"strings"
+
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
 )
 
-type format struct {
-	ID       string `yaml:"ext"`
-	Loc      string `yaml:"loc"`
-	BasePath string `yaml:"basepath,omitempty"`
-	BaseURL  string `yaml:"baseurl,omitempty"`
+type format = geofabrik.Format
+
+// miniColumns are formats with a download short flag, in miniFormats order.
+var miniColumns = []string{"state", "osm.pbf", "osm.bz2", "osh.pbf", "poly", "shp.zip", "kml"}
+
+// shortFlags give the download short flag of a format.
+var shortFlags = map[string]string{
+	"state":   "s",
+	"osm.pbf": "P",
+	"osm.bz2": "B",
+	"osh.pbf": "H",
+	"poly":    "p",
+	"shp.zip": "S",
+	"kml":     "k",
 }
 
 //miniFormats get formats of an Element
 // and return a string
 // according to download-geofabrik short flags.
-func miniFormats(s []string) string {
-	res := make([]string, 7)
-	for _, item := range s {
-		switch item {
-		case "state":
-			res[0] = "s"
-		case "osm.pbf":
-			res[1] = "P"
-		case "osm.bz2":
-			res[2] = "B"
-		case "osh.pbf":
-			res[3] = "H"
-		case "poly":
-			res[4] = "p"
-		case "shp.zip":
-			res[5] = "S"
-		case "kml":
-			res[6] = "k"
+// columns are formats to show in this order, default is miniColumns.
+// Formats without short flag are shown as [format].
+func miniFormats(s []string, columns ...string) string {
+	if len(columns) == 0 {
+		columns = miniColumns
+	}
+	res := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if !stringInSlice(&column, &s) {
+			continue
+		}
+		if short, ok := shortFlags[column]; ok {
+			res = append(res, short)
+		} else {
+			res = append(res, "["+column+"]")
 		}
 	}
 	return strings.Join(res, "")
 }
 
-func isHashable(c *Config, format string) (bool, string, string) {
-	hashs := []string{"md5"} // had to be globalized?
-	//h := "md5"
-	if _, ok := c.Formats[format]; ok {
-		for _, h := range hashs {
-			hash := format + "." + h
-			if _, ok := c.Formats[hash]; ok {
-				return true, hash, h
-			}
+// isChecksum is true if f is a checksum format, like osm.pbf.md5
+func isChecksum(f string) bool {
+	for _, h := range geofabrik.Checksums {
+		if strings.HasSuffix(f, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatColumns give formats of c without checksums.
+// Formats with a short flag are first, in miniColumns order.
+func formatColumns(c *Config) []string {
+	var columns []string
+	for _, f := range miniColumns {
+		if _, ok := c.Formats[f]; ok {
+			columns = append(columns, f)
+		}
+	}
+	for _, f := range formatKeys(c.Formats) {
+		if !stringInSlice(&f, &miniColumns) && !isChecksum(f) {
+			columns = append(columns, f)
+		}
+	}
+	return columns
+}
+
+// elementFormats give formats of e without checksums.
+func elementFormats(e *Element) []string {
+	var formats []string
+	for _, f := range e.Formats {
+		if !isChecksum(f) {
+			formats = append(formats, f)
 		}
 	}
-	return false, "", ""
+	return formats
+}
+
+// availableFormats give formats which are available for e.
+// Others are skipped with a warning, it's an error if none is available.
+func availableFormats(e *Element, formats []string) ([]string, error) {
+	if len(formats) == 1 && !stringInSlice(&formats[0], &e.Formats) {
+		return nil, geofabrik.Errorf(geofabrik.ErrFormatUnavailable, "%s is not available for %s", formats[0], e.ID)
+	}
+	var res []string
+	for _, f := range formats {
+		if stringInSlice(&f, &e.Formats) {
+			res = append(res, f)
+		} else if !*fQuiet {
+			log.Printf("Warning: %s is not available for %s, skipped", f, e.ID)
+		}
+	}
+	if len(res) == 0 && len(formats) > 0 {
+		return nil, geofabrik.Errorf(geofabrik.ErrFormatUnavailable, "none of %s is available for %s", strings.Join(formats, ", "), e.ID)
+	}
+	return res, nil
+}
+
+// splitFormats give formats of a comma separated list.
+func splitFormats(list string) []string {
+	var formats []string
+	for _, f := range strings.Split(list, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			formats = append(formats, f)
+		}
+	}
+	return formats
+}
+
+// preferredFormat give the first format of prefer available for e.
+func preferredFormat(e *Element, prefer []string) (string, error) {
+	for _, f := range prefer {
+		if stringInSlice(&f, &e.Formats) {
+			return f, nil
+		}
+	}
+	return "", geofabrik.Errorf(geofabrik.ErrFormatUnavailable, "none of %s is available for %s", strings.Join(prefer, ", "), e.ID)
+}
+
+// definedFormats give formats which are in c, others are dropped.
+func definedFormats(c *Config, formats []string) []string {
+	var defined []string
+	for _, f := range formats {
+		if _, ok := c.Formats[f]; ok {
+			defined = append(defined, f)
+		}
+	}
+	return defined
+}
+
+// checkFormats return an error if one of formats is not in c.
+func checkFormats(c *Config, formats []string) error {
+	for _, f := range formats {
+		if _, ok := c.Formats[f]; !ok {
+			return geofabrik.Errorf(geofabrik.ErrFormatUnavailable, "unknown format %s, available formats are: %s", f, strings.Join(formatColumns(c), ", "))
+		}
+	}
+	return nil
 }
 
 // getFormats return a pointer to a slice with formats
@@ -75,7 +170,12 @@ func getFormats() *[]string {
 	if *dkml {
 		formatFile = append(formatFile, "kml")
 	}
-	if len(formatFile) == 0 {
+	for _, f := range *dFormats {
+		if !stringInSlice(&f, &formatFile) {
+			formatFile = append(formatFile, f)
+		}
+	}
+	if len(formatFile) == 0 && *dPrefer == "" {
 		formatFile = append(formatFile, "osm.pbf")
 	}
 	return &formatFile
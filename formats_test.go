@@ -80,51 +80,6 @@ func Test_miniFormats(t *testing.T) {
 	}
 }
 
-func Test_isHashable(t *testing.T) {
-	type args struct {
-		format string
-		file   string
-	}
-	tests := []struct {
-		name  string
-		args  args
-		want  bool
-		want1 string
-		want2 string
-	}{
-		// TODO: Add test cases.
-		{name: "Test is osm.pbf is hashable", args: args{format: "osm.pbf", file: "./geofabrik.yml"}, want: true, want1: "osm.pbf.md5", want2: "md5"},
-		{name: "Test is kml is hashable", args: args{format: "kml", file: "./geofabrik.yml"}, want: false, want1: "", want2: ""},
-	}
-	for _, tt := range tests {
-		c, err := loadConfig(tt.args.file)
-		if err != nil {
-			t.Error(err)
-		}
-		t.Run(tt.name, func(t *testing.T) {
-			got, got1, got2 := isHashable(c, tt.args.format)
-			if got != tt.want {
-				t.Errorf("isHashable() got = %v, want %v", got, tt.want)
-			}
-			if got1 != tt.want1 {
-				t.Errorf("isHashable() got1 = %v, want %v", got1, tt.want1)
-			}
-			if got2 != tt.want2 {
-				t.Errorf("isHashable() got2 = %v, want %v", got2, tt.want2)
-			}
-		})
-	}
-}
-func Benchmark_isHashable_geofabrik_yml(b *testing.B) {
-	// run the Fib function b.N times
-	c, _ := loadConfig("./geofabrik.yml")
-	for n := 0; n < b.N; n++ {
-		for f := range c.Formats {
-			isHashable(c, f)
-		}
-	}
-}
-
 func Test_getFormats(t *testing.T) {
 	type dflags struct {
 		dosmPbf bool
@@ -242,3 +197,198 @@ func Test_getFormats(t *testing.T) {
 		})
 	}
 }
+
+func Test_miniFormats_columns(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       []string
+		columns []string
+		want    string
+	}{
+		{name: "gislab columns", s: []string{"osm.pbf", "osm.bz2", "poly"}, columns: []string{"osm.pbf", "osm.bz2", "poly"}, want: "PBp"},
+		{name: "format without short flag", s: []string{"osm.pbf", "garmin"}, columns: []string{"osm.pbf", "garmin", "geojson"}, want: "P[garmin]"},
+		{name: "format not in columns", s: []string{"osm.pbf", "garmin"}, columns: []string{"osm.pbf"}, want: "P"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := miniFormats(tt.s, tt.columns...); got != tt.want {
+				t.Errorf("miniFormats() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_formatColumns(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want []string
+	}{
+		{name: "geofabrik", file: "./geofabrik.yml", want: []string{"state", "osm.pbf", "osm.bz2", "poly", "shp.zip", "kml"}},
+		{name: "gislab", file: "./gislab.yml", want: []string{"osm.pbf", "osm.bz2", "poly"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := loadConfig(tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := formatColumns(c); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("formatColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	c := &Config{Formats: map[string]format{"osm.pbf": {ID: "osm.pbf"}, "osm.pbf.md5": {ID: "osm.pbf.md5"}, "geojson": {ID: "geojson"}, "garmin": {ID: "garmin"}}}
+	if got, want := formatColumns(c), []string{"osm.pbf", "garmin", "geojson"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("formatColumns() = %v, want %v", got, want)
+	}
+}
+
+func Test_checkFormats(t *testing.T) {
+	c, err := loadConfig("./gislab.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		formats []string
+		wantErr bool
+	}{
+		{name: "osm.pbf", formats: []string{"osm.pbf"}},
+		{name: "all gislab formats", formats: []string{"osm.pbf", "osm.bz2", "poly"}},
+		{name: "no shp.zip on gislab", formats: []string{"osm.pbf", "shp.zip"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkFormats(c, tt.formats); (err != nil) != tt.wantErr {
+				t.Errorf("checkFormats() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_getFormats_format(t *testing.T) {
+	tests := []struct {
+		name    string
+		dosmPbf bool
+		formats []string
+		want    []string
+	}{
+		{name: "-f garmin", formats: []string{"garmin"}, want: []string{"garmin"}},
+		{name: "-P -f osm.pbf -f poly", dosmPbf: true, formats: []string{"osm.pbf", "poly"}, want: []string{"osm.pbf", "poly"}},
+	}
+	*doshPbf, *dosmBz2, *dshpZip, *dstate, *dpoly, *dkml = false, false, false, false, false, false
+	defer func() { *dosmPbf = false; *dFormats = nil }()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*dosmPbf = tt.dosmPbf
+			*dFormats = tt.formats
+			if got := getFormats(); !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("getFormats() = %v, want %v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_elementFormats(t *testing.T) {
+	e := &Element{ID: "monaco", Formats: []string{"osm.pbf", "osm.pbf.md5", "osm.bz2", "osm.bz2.md5", "poly", "kml", "state"}}
+	want := []string{"osm.pbf", "osm.bz2", "poly", "kml", "state"}
+	if got := elementFormats(e); !reflect.DeepEqual(got, want) {
+		t.Errorf("elementFormats() = %v, want %v", got, want)
+	}
+}
+
+func Test_availableFormats(t *testing.T) {
+	e := &Element{ID: "monaco", Formats: []string{"osm.pbf", "osm.pbf.md5", "poly"}}
+	tests := []struct {
+		name    string
+		formats []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "available", formats: []string{"osm.pbf", "poly"}, want: []string{"osm.pbf", "poly"}},
+		{name: "shp.zip skipped", formats: []string{"osm.pbf", "shp.zip", "poly"}, want: []string{"osm.pbf", "poly"}},
+		{name: "only shp.zip", formats: []string{"shp.zip"}, wantErr: true},
+		{name: "none available", formats: []string{"shp.zip", "osm.bz2"}, wantErr: true},
+	}
+	*fQuiet = true
+	defer func() { *fQuiet = false }()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := availableFormats(e, tt.formats)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("availableFormats() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("availableFormats() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_splitFormats(t *testing.T) {
+	tests := []struct {
+		name string
+		list string
+		want []string
+	}{
+		{name: "two formats", list: "osm.pbf,osm.bz2", want: []string{"osm.pbf", "osm.bz2"}},
+		{name: "spaces and empty", list: " osm.pbf, ,osm.bz2,", want: []string{"osm.pbf", "osm.bz2"}},
+		{name: "empty", list: "", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitFormats(tt.list); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitFormats() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_definedFormats(t *testing.T) {
+	c := &Config{Formats: map[string]format{"osm.pbf": {ID: "osm.pbf"}, "poly": {ID: "poly"}}}
+	tests := []struct {
+		name    string
+		formats []string
+		want    []string
+	}{
+		{name: "all defined", formats: []string{"poly", "osm.pbf"}, want: []string{"poly", "osm.pbf"}},
+		{name: "unknown dropped", formats: []string{"osm.bz2", "osm.pbf"}, want: []string{"osm.pbf"}},
+		{name: "none defined", formats: []string{"osm.bz2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := definedFormats(c, tt.formats); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("definedFormats() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_preferredFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		e       *Element
+		prefer  []string
+		want    string
+		wantErr bool
+	}{
+		{name: "first available", e: &Element{ID: "monaco", Formats: []string{"osm.pbf", "osm.bz2"}}, prefer: []string{"osm.pbf", "osm.bz2"}, want: "osm.pbf"},
+		{name: "fallback", e: &Element{ID: "AM", Formats: []string{"osm.bz2", "poly"}}, prefer: []string{"osm.pbf", "osm.bz2"}, want: "osm.bz2"},
+		{name: "preference order", e: &Element{ID: "monaco", Formats: []string{"osm.pbf", "osm.bz2"}}, prefer: []string{"osm.bz2", "osm.pbf"}, want: "osm.bz2"},
+		{name: "none", e: &Element{ID: "us", Meta: true}, prefer: []string{"osm.pbf", "osm.bz2"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := preferredFormat(tt.e, tt.prefer)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("preferredFormat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("preferredFormat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// +build ignore
+
+// genconfigs write configs.go with the content of shipped config files.
+// Run it with go generate after updating a config file.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+var configFiles = []string{"geofabrik.yml", "openstreetmap.fr.yml", "gislab.yml", "geofabrik.rules.yml"}
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by go run genconfigs.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package main\n\n")
+	buf.WriteString("// embeddedConfigs are config files shipped in the binary.\n")
+	buf.WriteString("// They are used when the file is not found.\n")
+	buf.WriteString("var embeddedConfigs = map[string]string{\n")
+	for _, name := range configFiles {
+		content, err := ioutil.ReadFile(name)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		if strings.Contains(string(content), "`") {
+			log.Fatalln(name, "contain a backquote")
+		}
+		fmt.Fprintf(&buf, "\t%q: `%s`,\n", name, content)
+	}
+	buf.WriteString("}\n")
+	if err := ioutil.WriteFile("configs.go", buf.Bytes(), 0644); err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -1,40 +1,79 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/gocrawl"
 	"github.com/PuerkitoBio/goquery"
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
 	pb "gopkg.in/cheggaaa/pb.v1"
-	yaml "gopkg.in/yaml.v2"
 )
 
 var bar *pb.ProgressBar
 
+// errUnsupported is the kind of errors for services and hosts generate can't crawl.
+var errUnsupported = errors.New("unsupported service")
+
 // ElementSlice contain all Elements
 // TODO: It's not a slice but a MAP!!!!
 type ElementSlice map[string]Element
 
 // Generate make the slice which contain all Elements
 func (e ElementSlice) Generate(myConfig *Config) ([]byte, error) {
+	return e.GenerateAs(myConfig, "yaml")
+}
+
+// GenerateAs is like Generate but in format: "yaml", "json" or "toml".
+func (e ElementSlice) GenerateAs(myConfig *Config, format string) ([]byte, error) {
 	myConfig.Elements = e
-	return yaml.Marshal(myConfig)
+	return geofabrik.MarshalConfig(myConfig, format)
 }
 
 // Ext simple struct for managing ElementSlice and crawler
 type Ext struct {
 	*gocrawl.DefaultExtender
 	Elements ElementSlice
+	Record   string       // if not empty, save every fetched page in this directory
+	Replay   *Replayer    // if not nil, pages are served from a recorded directory
+	Client   *http.Client // if not nil, used to fetch pages instead of gocrawl client
+	Roots    []string     // if not empty, only crawl these pages and their children
+	Rules    *Rules       // fixups for the service
+	Err      error        // first error while parsing pages
+}
+
+// Fetch get pages from the web with Client, or from Replay.
+// If Record is set, pages are saved for future replay.
+func (e *Ext) Fetch(ctx *gocrawl.URLContext, userAgent string, headRequest bool) (*http.Response, error) {
+	if e.Replay != nil {
+		return e.Replay.Fetch(ctx.URL(), userAgent, headRequest)
+	}
+	var res *http.Response
+	var err error
+	if e.Client != nil {
+		res, err = fetch(e.Client, ctx.URL().String(), userAgent, headRequest)
+	} else {
+		res, err = e.DefaultExtender.Fetch(ctx, userAgent, headRequest)
+	}
+	if err != nil || e.Record == "" || headRequest {
+		return res, err
+	}
+	if err := recordPage(e.Record, ctx.URL(), res); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // addHash find if a hash is available and append it to e
-func (e *Element) addHash(myel *goquery.Selection) {
+func addHash(e *Element, myel *goquery.Selection) {
 	a := myel.Find("a")
 	if a.Length() == 2 { // If only 1 a there is no hash
 		validHash := []string{"md5"}
@@ -51,6 +90,45 @@ func (e *Element) addHash(myel *goquery.Selection) {
 	}
 }
 
+var (
+	geofabrikDate = regexp.MustCompile(`contains all OSM data up to (\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z)`)
+	geofabrikSize = regexp.MustCompile(`File size: ([0-9.]+ ?(?i:[KMGT]?i?B))`)
+)
+
+// geofabrikInfo find date and size in the description of a geofabrik file.
+// Pages tell "last modified 11 hours ago and contains all OSM data up to 2019-04-02T19:00:00Z",
+// so the date is the one of the data.
+func geofabrikInfo(text string) FileInfo {
+	var info FileInfo
+	if m := geofabrikDate.FindStringSubmatch(text); m != nil {
+		info.Date, _ = time.Parse(time.RFC3339, m[1])
+	}
+	if m := geofabrikSize.FindStringSubmatch(text); m != nil {
+		info.Size = parseSize(m[1])
+	}
+	return info
+}
+
+// osmfrInfo read date and size columns of an openstreetmap.fr directory listing.
+func osmfrInfo(line *goquery.Selection) FileInfo {
+	var info FileInfo
+	tds := line.Find("td")
+	if tds.Length() < 4 {
+		return info
+	}
+	info.Date, _ = time.Parse("2006-01-02 15:04", strings.TrimSpace(tds.Eq(2).Text()))
+	info.Size = parseSize(tds.Eq(3).Text())
+	return info
+}
+
+// geofabrikID give the element of an osm.pbf link,
+// like monaco-latest.osm.pbf or monaco-latest-internal.osm.pbf
+func geofabrikID(link string) string {
+	id := strings.TrimSuffix(link, ".osm.pbf")
+	id = strings.TrimSuffix(id, "-internal")
+	return strings.TrimSuffix(id, "-latest")
+}
+
 func (e *Ext) parseGeofabrik(ctx *gocrawl.URLContext, res *http.Response, doc *goquery.Document) (interface{}, bool) {
 	var thisElement Element
 	downloadMain := doc.Find("div.download-main")
@@ -79,9 +157,10 @@ func (e *Ext) parseGeofabrik(ctx *gocrawl.URLContext, res *http.Response, doc *g
 						if extFound {
 							switch v {
 							case "osm.pbf":
-								thisElement.ID = linkval[0 : len(linkval)-15]
+								thisElement.ID = geofabrikID(linkval)
 								thisElement.Formats = append(thisElement.Formats, v)
-								thisElement.addHash(myel)
+								thisElement.AddInfo(v, geofabrikInfo(myel.Text()))
+								addHash(&thisElement, myel)
 							case "poly":
 								thisElement.Formats = append(thisElement.Formats, v)
 								thisElement.Formats = append(thisElement.Formats, "kml")
@@ -89,7 +168,8 @@ func (e *Ext) parseGeofabrik(ctx *gocrawl.URLContext, res *http.Response, doc *g
 								thisElement.Formats = append(thisElement.Formats, "state")
 							default:
 								thisElement.Formats = append(thisElement.Formats, v)
-								thisElement.addHash(myel)
+								thisElement.AddInfo(v, geofabrikInfo(myel.Text()))
+								addHash(&thisElement, myel)
 							}
 						}
 					}
@@ -99,86 +179,7 @@ func (e *Ext) parseGeofabrik(ctx *gocrawl.URLContext, res *http.Response, doc *g
 		if len(thisElement.Formats) == 0 {
 			thisElement.Meta = true
 		}
-		// Workaround to fix #10
-		var us Element
-		us.Meta = true
-		us.ID = "us"
-		us.Name = "United States of America"
-		us.Parent = "north-america"
-		us.Formats = []string{}
-		e.Elements[us.ID] = us
-
-		//Exceptions!
-		// Only Georgia (EU and US)
-		if thisElement.ID == "georgia" {
-			thisElement.File = "georgia"
-			if thisElement.Parent == "europe" {
-				thisElement.Name = "Georgia (Europe country)"
-				thisElement.ID = "georgia-eu"
-			} else {
-				thisElement.Name = "Georgia (US State)"
-				thisElement.ID = "georgia-us"
-				thisElement.Parent = "us"
-			}
-		}
-		// List of US to fix #10
-		usList := map[string]bool{
-			"alabama":              true,
-			"alaska":               true,
-			"arizona":              true,
-			"arkansas":             true,
-			"california":           true,
-			"colorado":             true,
-			"connecticut":          true,
-			"delaware":             true,
-			"district-of-columbia": true,
-			"florida":              true,
-			"georgia":              false, // Since there is also georgia in europe....
-			"hawaii":               true,
-			"idaho":                true,
-			"illinois":             true,
-			"indiana":              true,
-			"iowa":                 true,
-			"kansas":               true,
-			"kentucky":             true,
-			"louisiana":            true,
-			"maine":                true,
-			"maryland":             true,
-			"massachusetts":        true,
-			"michigan":             true,
-			"minnesota":            true,
-			"mississippi":          true,
-			"missouri":             true,
-			"montana":              true,
-			"nebraska":             true,
-			"nevada":               true,
-			"new-hampshire":        true,
-			"new-jersey":           true,
-			"new-mexico":           true,
-			"new-york":             true,
-			"north-carolina":       true,
-			"north-dakota":         true,
-			"ohio":                 true,
-			"oklahoma":             true,
-			"oregon":               true,
-			"pennsylvania":         true,
-			"puerto-rico":          true,
-			"rhode-island":         true,
-			"south-carolina":       true,
-			"south-dakota":         true,
-			"tennessee":            true,
-			"texas":                true,
-			"utah":                 true,
-			"vermont":              true,
-			"virginia":             true,
-			"washington":           true,
-			"west-virginia":        true,
-			"wisconsin":            true,
-			"wyoming":              true}
-
-		if usList[thisElement.ID] {
-			thisElement.Parent = "us"
-		}
+		e.Rules.rename(&thisElement) // georgia is in europe and in us
 		if thisElement.Name != "OpenStreetMap Data Extracts" {
 			e.Elements[thisElement.ID] = thisElement
 		}
@@ -188,10 +189,13 @@ func (e *Ext) parseGeofabrik(ctx *gocrawl.URLContext, res *http.Response, doc *g
 
 func (e *Ext) mergeElement(element *Element) error {
 	if cE, ok := e.Elements[element.ID]; ok {
-		if cE.Parent != element.Parent {
-			return fmt.Errorf("Cant merge : Parent mismatch")
+		if err := geofabrik.CanMerge(&cE, element); err != nil {
+			return err
 		}
 		cE.Formats = append(cE.Formats, element.Formats...)
+		for f, info := range element.Info {
+			cE.AddInfo(f, info)
+		}
 		if len(cE.Formats) == 0 {
 			cE.Meta = true
 		} else {
@@ -234,14 +238,17 @@ func (e *Ext) parseOSMfr(ctx *gocrawl.URLContext, res *http.Response, doc *goque
 					}
 					element.ID = name
 					element.Name = name
+					e.Rules.rename(&element)
+					name = element.ID
+					info := osmfrInfo(singleElement)
 					if *fVerbose && !*fQuiet && !*fProgress {
 						log.Println("parsing", vallink)
 					}
 					if !strings.EqualFold(e.Elements[name].ID, name) {
 						element.Formats = append(element.Formats, ext)
-						err := e.mergeElement(&element)
-						if err != nil {
-							log.Panicln("Can't merge element,", err)
+						element.AddInfo(ext, info)
+						if err := e.mergeElement(&element); err != nil && e.Err == nil {
+							e.Err = fmt.Errorf("Can't merge element, %v", err)
 						}
 					} else {
 						if *fVerbose && !*fQuiet && !*fProgress {
@@ -255,6 +262,7 @@ func (e *Ext) parseOSMfr(ctx *gocrawl.URLContext, res *http.Response, doc *goque
 							et.Meta = false
 						}
 						et.Formats = append(et.Formats, ext)
+						et.AddInfo(ext, info)
 						e.Elements[name] = et
 					}
 				}
@@ -275,12 +283,12 @@ func (e *Ext) parseGisLab(ctx *gocrawl.URLContext, res *http.Response, doc *goqu
 			element.Formats = append(element.Formats, "osm.pbf") // Not checked elements
 			element.Formats = append(element.Formats, "osm.bz2") // Pray for non changing data structure...
 			element.Formats = append(element.Formats, "poly")    // Not checked but seems to be used for generating osm.pbf/osm.bz2
+			e.Rules.rename(&element)
 			if *fVerbose && !*fQuiet {
 				log.Println("Adding", element.Name)
 			}
-			err := e.mergeElement(&element)
-			if err != nil {
-				log.Panicln("Can't merge element,", err)
+			if err := e.mergeElement(&element); err != nil && e.Err == nil {
+				e.Err = fmt.Errorf("Can't merge element, %v", err)
 			}
 		}
 	}
@@ -290,22 +298,24 @@ func (e *Ext) parseGisLab(ctx *gocrawl.URLContext, res *http.Response, doc *goqu
 // Visit launch right crawler
 func (e *Ext) Visit(ctx *gocrawl.URLContext, res *http.Response, doc *goquery.Document) (interface{}, bool) {
 	if *fVerbose && !*fQuiet && !*fProgress {
-		fmt.Printf("Visit: %s\n", ctx.URL())
+		log.Println("Visit:", ctx.URL())
 	}
 	if *fProgress {
 		bar.Increment()
 	}
 	switch ctx.URL().Host {
-	case "download.geofabrik.de":
+	case "download.geofabrik.de", internalHost:
 		return e.parseGeofabrik(ctx, res, doc)
 	case "download.openstreetmap.fr":
 		return e.parseOSMfr(ctx, res, doc)
 	case "be.gis-lab.info":
 		return e.parseGisLab(ctx, res, doc)
 	default:
-		panic(fmt.Sprintln("Panic! " + ctx.URL().Host + " is not supported!"))
+		if e.Err == nil {
+			e.Err = geofabrik.Errorf(errUnsupported, "%s is not supported", ctx.URL().Host)
+		}
+		return nil, false
 	}
-
 }
 
 // Filter remove non needed urls.
@@ -313,6 +323,9 @@ func (e *Ext) Filter(ctx *gocrawl.URLContext, isVisited bool) bool {
 	if isVisited {
 		return false
 	}
+	if len(e.Roots) != 0 && !inSubtree(e.Roots, ctx.URL()) {
+		return false
+	}
 	if len(ctx.URL().RawQuery) != 0 {
 		return false
 		// TODO: refactorize? Use config file?
@@ -355,15 +368,56 @@ func (e *Ext) Filter(ctx *gocrawl.URLContext, isVisited bool) bool {
 }
 
 // GenerateCrawler creating a gocrawl to parse the website.
-func GenerateCrawler(url string, fname string, myConfig *Config) {
-	ext := &Ext{&gocrawl.DefaultExtender{}, make(map[string]Element)}
+// If ctx is cancelled, crawler is stopped and fname is not written.
+func GenerateCrawler(ctx context.Context, url string, fname string, myConfig *Config) error {
+	ext := &Ext{DefaultExtender: &gocrawl.DefaultExtender{}, Elements: make(map[string]Element), Record: *gRecord}
+	var err error
+	if *gRules != "" {
+		ext.Rules, err = loadRules(*gRules, true)
+	} else {
+		ext.Rules, err = loadRules(defaultRulesFile(*fService), false)
+	}
+	if err != nil {
+		return fmt.Errorf("Can't load rules: %v", err)
+	}
 	// Set custom options
 	opts := gocrawl.NewOptions(ext)
 	opts.CrawlDelay = 100 * time.Millisecond
+	if *gReplay != "" {
+		replayer, err := newReplayer(*gReplay)
+		if err != nil {
+			return err
+		}
+		defer replayer.Close()
+		ext.Replay = replayer
+		opts.CrawlDelay = 0 // Local server, no need to be polite
+	} else {
+		auths := clientAuths(myConfig)
+		if err = checkCookies(auths); err != nil {
+			return err
+		}
+		if ext.Client, err = newClient(auths); err != nil {
+			return err
+		}
+	}
+	var seeds interface{} = url
+	var existing *Config
+	if *gRoot != "" {
+		existing, err = loadConfig(fname)
+		if err != nil {
+			return fmt.Errorf("Can't merge %s into %s: %v", *gRoot, fname, err)
+		}
+		ext.Roots, err = subtreeSeeds(existing, *gRoot)
+		if err != nil {
+			return err
+		}
+		seeds = ext.Roots
+	}
 	opts.LogFlags = gocrawl.LogError
 	//	opts.LogFlags = gocrawl.LogAll
 	opts.SameHostOnly = true //false
-	opts.UserAgent = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/53.0.2785.116 Safari/537.36"
+	opts.UserAgent = *fUserAgent
+	opts.RobotUserAgent = "download-geofabrik" // Default is Googlebot
 	opts.MaxVisits = 15000
 
 	file := gocrawl.NewCrawlerWithOptions(opts)
@@ -380,20 +434,44 @@ func GenerateCrawler(url string, fname string, myConfig *Config) {
 		bar = pb.New(maxPb)
 		bar.Start()
 	}
-	err := file.Run(url)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			file.Stop()
+		case <-done:
+		}
+	}()
+	err = file.Run(seeds)
+	close(done)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
 	if err != nil {
-		log.Panicln(err)
+		return err
 	}
-	out, _ := ext.Elements.Generate(myConfig)
-	filename, _ := filepath.Abs(fname)
-	err = ioutil.WriteFile(filename, out, 0644)
+	if ext.Err != nil {
+		return ext.Err
+	}
+	ext.Rules.Apply(ext.Elements)
+	if existing != nil {
+		ext.Elements = mergeSubtree(existing.Elements, ext.Elements, *gRoot)
+	}
+	myConfig.Version = geofabrik.ConfigVersion
+	myConfig.Generated = time.Now().UTC().Truncate(time.Second)
+	out, err := ext.Elements.GenerateAs(myConfig, geofabrik.ConfigFormat(fname, nil))
 	if err != nil {
-		log.Panicln(fmt.Errorf(" File error: %v ", err))
+		return err
 	}
+	filename, _ := filepath.Abs(fname)
+	if err = ioutil.WriteFile(filename, out, 0644); err != nil {
+		return fmt.Errorf("File error: %v", err)
+	}
+	return nil
 }
 
 //Generate main function
-func Generate(configfile string) {
+func Generate(ctx context.Context, configfile string) error {
 	switch *fService {
 	case "geofabrik":
 		//Generate geofabrik.yml
@@ -401,8 +479,7 @@ func Generate(configfile string) {
 		geofabrik.BaseURL = "https://download.geofabrik.de"
 		geofabrik.Formats = make(map[string]format)
 		//TODO: make a function for adding formats
-		//geofabrik.Formats["osh.pbf"] = format{ID: "osh.pbf", Loc: ".osh.pbf"}
-		//geofabrik.Formats["osh.pbf.md5"] = format{ID: "osh.pbf.md5", Loc: ".osh.pbf.md5"}
+		// osh.pbf are only on geofabrik-internal
 		geofabrik.Formats["osm.bz2"] = format{ID: "osm.bz2", Loc: "-latest.osm.bz2"}
 		geofabrik.Formats["osm.bz2.md5"] = format{ID: "osm.bz2.md5", Loc: "-latest.osm.bz2.md5"}
 		geofabrik.Formats["osm.pbf"] = format{ID: "osm.pbf", Loc: "-latest.osm.pbf"}
@@ -411,7 +488,31 @@ func Generate(configfile string) {
 		geofabrik.Formats["kml"] = format{ID: "kml", Loc: ".kml"}
 		geofabrik.Formats["state"] = format{ID: "state", Loc: "-updates/state.txt"}
 		geofabrik.Formats["shp.zip"] = format{ID: "shp.zip", Loc: "-latest-free.shp.zip"}
-		GenerateCrawler("https://download.geofabrik.de/", configfile, &geofabrik)
+		if err := GenerateCrawler(ctx, "https://download.geofabrik.de/", configfile, &geofabrik); err != nil {
+			return err
+		}
+		if !*fQuiet {
+			log.Println(configfile, " generated.")
+		}
+
+	case "geofabrik-internal":
+		// Same elements as geofabrik, with user and changeset metadata, after OSM login
+		var myConfig Config
+		myConfig.BaseURL = "https://" + internalHost
+		myConfig.Formats = make(map[string]format)
+		myConfig.Formats["osh.pbf"] = format{ID: "osh.pbf", Loc: "-internal.osh.pbf"}
+		myConfig.Formats["osh.pbf.md5"] = format{ID: "osh.pbf.md5", Loc: "-internal.osh.pbf.md5"}
+		myConfig.Formats["osm.pbf"] = format{ID: "osm.pbf", Loc: "-latest-internal.osm.pbf"}
+		myConfig.Formats["osm.pbf.md5"] = format{ID: "osm.pbf.md5", Loc: "-latest-internal.osm.pbf.md5"}
+		myConfig.Formats["osm.bz2"] = format{ID: "osm.bz2", Loc: "-latest.osm.bz2", BaseURL: "https://download.geofabrik.de"}
+		myConfig.Formats["osm.bz2.md5"] = format{ID: "osm.bz2.md5", Loc: "-latest.osm.bz2.md5", BaseURL: "https://download.geofabrik.de"}
+		myConfig.Formats["poly"] = format{ID: "poly", Loc: ".poly"}
+		myConfig.Formats["kml"] = format{ID: "kml", Loc: ".kml"}
+		myConfig.Formats["state"] = format{ID: "state", Loc: "-updates/state.txt"}
+		myConfig.Formats["shp.zip"] = format{ID: "shp.zip", Loc: "-latest-free.shp.zip", BaseURL: "https://download.geofabrik.de"}
+		if err := GenerateCrawler(ctx, "https://"+internalHost+"/", configfile, &myConfig); err != nil {
+			return err
+		}
 		if !*fQuiet {
 			log.Println(configfile, " generated.")
 		}
@@ -423,7 +524,9 @@ func Generate(configfile string) {
 		myConfig.Formats["osm.pbf"] = format{ID: "osm.pbf", Loc: "-latest.osm.pbf"}
 		myConfig.Formats["poly"] = format{ID: "poly", Loc: ".poly", BasePath: "../polygons/"}
 		myConfig.Formats["state"] = format{ID: "state", Loc: ".state.txt"}
-		GenerateCrawler("https://download.openstreetmap.fr/", configfile, &myConfig)
+		if err := GenerateCrawler(ctx, "https://download.openstreetmap.fr/", configfile, &myConfig); err != nil {
+			return err
+		}
 		if !*fQuiet {
 			log.Println(configfile, " generated.")
 		}
@@ -434,12 +537,14 @@ func Generate(configfile string) {
 		myConfig.Formats["osm.pbf"] = format{ID: "osm.pbf", BaseURL: "http://data.gis-lab.info/osm_dump/dump", BasePath: "latest/", Loc: ".osm.pbf"}
 		myConfig.Formats["osm.bz2"] = format{ID: "osm.bz2", BaseURL: "http://data.gis-lab.info/osm_dump/dump", BasePath: "latest/", Loc: ".osm.bz2"}
 		myConfig.Formats["poly"] = format{ID: "poly", BaseURL: "https://raw.githubusercontent.com/nextgis/osmdump_poly/master", Loc: ".poly"}
-		GenerateCrawler("http://be.gis-lab.info/project/osm_dump/iframe.php", configfile, &myConfig)
+		if err := GenerateCrawler(ctx, "http://be.gis-lab.info/project/osm_dump/iframe.php", configfile, &myConfig); err != nil {
+			return err
+		}
 		if !*fQuiet {
 			log.Println(configfile, " generated.")
 		}
 	default:
-		log.Println("Service not reconized, please use one of geofabrik, openstreetmap.fr or gislab")
+		return geofabrik.Errorf(errUnsupported, "Service not reconized, please use one of geofabrik, geofabrik-internal, openstreetmap.fr or gislab")
 	}
-
+	return nil
 }
@@ -1,26 +1,36 @@
 package main
 
 import (
+	"context"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/PuerkitoBio/gocrawl"
 	"github.com/PuerkitoBio/goquery"
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
 	yaml "gopkg.in/yaml.v2"
 )
 
-//Sample data:
-func getHTML(url string) string {
-	resp, err := http.Get(url)
+// Sample data:
+// getHTML read pages of testdata.
+// They are hand-made, trimmed copies of service pages, stored like generate --record
+// write them so generate --replay can use them. Edit them when pages change.
+func getHTML(myURL string) string {
+	u, err := url.Parse(myURL)
 	if err != nil {
-		// handle error
+		panic(err)
+	}
+	body, err := ioutil.ReadFile(fixturePath("testdata", u))
+	if err != nil {
+		panic(err)
 	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
 	return string(body)
 }
 
@@ -38,6 +48,33 @@ var geofabrikShikokuHTML = getHTML("https://download.geofabrik.de/asia/japan/shi
 
 var gislabSampleHTML = getHTML("http://be.gis-lab.info/project/osm_dump/iframe.php")
 
+// FileInfo found in testdata
+var (
+	osmfrExtractDate = time.Date(2019, 4, 2, 3, 12, 0, 0, time.UTC)
+	osmfrExtractInfo = map[string]FileInfo{
+		"osm.pbf":     {Size: 12 << 30, Date: osmfrExtractDate},
+		"osm.pbf.md5": {Size: 49, Date: osmfrExtractDate},
+		"state":       {Size: 107, Date: osmfrExtractDate},
+	}
+	osmfrMergeDate = time.Date(2019, 4, 2, 5, 40, 0, 0, time.UTC)
+	osmfrMergeInfo = map[string]FileInfo{
+		"osm.pbf": {Size: 4089446, Date: osmfrMergeDate}, // 3.9M
+		"state":   {Size: 107, Date: osmfrMergeDate},
+	}
+	osmfrPolyInfo = map[string]FileInfo{
+		"poly": {Size: 4198, Date: time.Date(2018, 11, 20, 17, 45, 0, 0, time.UTC)}, // 4.1K
+	}
+	geofabrikPageInfo = map[string]FileInfo{
+		"osm.pbf": {Size: 64382566, Date: time.Date(2019, 4, 2, 19, 0, 0, 0, time.UTC)}, // 61.4 MB
+		"osm.bz2": {Size: 126 << 20, Date: time.Date(2019, 4, 2, 19, 0, 0, 0, time.UTC)},
+	}
+	geofabrikPageInfoWithShp = map[string]FileInfo{
+		"osm.pbf": geofabrikPageInfo["osm.pbf"],
+		"osm.bz2": geofabrikPageInfo["osm.bz2"],
+		"shp.zip": {Size: 112 << 20},
+	}
+)
+
 func TestElementSlice_Generate(t *testing.T) {
 	myConfig := &SampleConfigValidPtr
 	myYaml, _ := yaml.Marshal(*myConfig)
@@ -76,21 +113,87 @@ func TestElementSlice_Generate(t *testing.T) {
 	}
 }
 
+func TestGenerate_unknownService(t *testing.T) {
+	*fService = "anothermap"
+	defer func() { *fService = "" }()
+	err := Generate(context.Background(), "anothermap.yml")
+	if geofabrik.Cause(err) != errUnsupported {
+		t.Errorf("Generate() error = %v, want %v", err, errUnsupported)
+	}
+	if exitCode(err) == 0 {
+		t.Errorf("exitCode() of Generate() error = 0, want an error code")
+	}
+}
+
 func TestGenerate(t *testing.T) {
+	*fQuiet = true
+	*gReplay = "testdata"
+	defer func() { *gReplay = "" }()
 	type args struct {
 		configfile string
 	}
 	tests := []struct {
-		name string
-		args args
+		name    string
+		service string
+		args    args
+		want    ElementSlice // must be in generated config
 	}{
-		// TODO: Add test cases.
+		{
+			name:    "geofabrik from testdata",
+			service: "geofabrik",
+			args:    args{configfile: "download-geofabrik-generate-test.yml"},
+			want: ElementSlice{
+				"south-america":        {ID: "south-america", Name: "South America", Formats: []string{"osm.pbf", "osm.pbf.md5", "osm.bz2", "osm.bz2.md5", "poly", "kml", "state"}, Info: geofabrikPageInfo},
+				"japan":                {ID: "japan", Name: "Japan", Formats: []string{"osm.pbf", "osm.pbf.md5", "shp.zip", "osm.bz2", "osm.bz2.md5", "poly", "kml", "state"}, Parent: "asia", Info: geofabrikPageInfoWithShp},
+				"shikoku":              {ID: "shikoku", Name: "Shikoku", Formats: []string{"osm.pbf", "osm.pbf.md5", "shp.zip", "osm.bz2", "osm.bz2.md5", "poly", "kml", "state"}, Parent: "japan", Info: geofabrikPageInfoWithShp},
+				"district-of-columbia": {ID: "district-of-columbia", Name: "District of Columbia", Formats: []string{"osm.pbf", "osm.pbf.md5", "shp.zip", "osm.bz2", "osm.bz2.md5", "poly", "kml", "state"}, Parent: "us", Info: geofabrikPageInfoWithShp},
+				"us":                   {ID: "us", Meta: true, Name: "United States of America", Parent: "north-america"},
+			},
+		},
+		{
+			name:    "openstreetmap.fr from testdata",
+			service: "openstreetmap.fr",
+			args:    args{configfile: "download-geofabrik-generate-test.yml"},
+			want: ElementSlice{
+				"europe":  {ID: "europe", Name: "europe", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Info: osmfrExtractInfo},
+				"fiji":    {ID: "fiji", Name: "fiji", Formats: []string{"osm.pbf", "state"}, Parent: "merge", Info: osmfrMergeInfo},
+				"shikoku": {ID: "shikoku", Name: "shikoku", Formats: []string{"poly"}, Parent: "japan", Info: osmfrPolyInfo},
+			},
+		},
+		{
+			name:    "gislab from testdata",
+			service: "gislab",
+			args:    args{configfile: "download-geofabrik-generate-test.yml"},
+			want: ElementSlice{
+				"AM": {ID: "AM", Name: "Армения", Formats: []string{"osm.pbf", "osm.bz2", "poly"}},
+			},
+		},
 	}
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
 	for _, tt := range tests {
+		*fService = tt.service
 		t.Run(tt.name, func(t *testing.T) {
-			Generate(tt.args.configfile)
+			configfile := filepath.Join(dir, tt.args.configfile)
+			defer os.Remove(configfile)
+			if err := Generate(context.Background(), configfile); err != nil {
+				t.Fatal(err)
+			}
+			c, err := loadConfig(configfile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for id, want := range tt.want {
+				if got := c.Elements[id]; !reflect.DeepEqual(got, want) {
+					t.Errorf("Generate() %s = %+v, want %+v", id, got, want)
+				}
+			}
 		})
 	}
+	*fService = "geofabrik"
 }
 
 func TestExt_mergeElement(t *testing.T) {
@@ -147,7 +250,7 @@ func TestExt_mergeElement(t *testing.T) {
 	}
 }
 
-func Benchmark_Element_addHash_noHash(b *testing.B) {
+func Benchmark_addHash_noHash(b *testing.B) {
 	sampleElement := Element{
 		ID:      "test",
 		Formats: []string{"osm.pbf"},
@@ -159,10 +262,10 @@ func Benchmark_Element_addHash_noHash(b *testing.B) {
 	}
 	sampleSelection := doc.Find("p")
 	for n := 0; n < b.N; n++ {
-		sampleElement.addHash(sampleSelection)
+		addHash(&sampleElement, sampleSelection)
 	}
 }
-func Benchmark_Element_addHash_Hash(b *testing.B) {
+func Benchmark_addHash_Hash(b *testing.B) {
 	sampleElement := Element{
 		ID:      "test",
 		Formats: []string{"osm.pbf"},
@@ -174,11 +277,11 @@ func Benchmark_Element_addHash_Hash(b *testing.B) {
 	}
 	sampleSelection := doc.Find("p")
 	for n := 0; n < b.N; n++ {
-		sampleElement.addHash(sampleSelection)
+		addHash(&sampleElement, sampleSelection)
 	}
 }
 
-func TestElement_addHash(t *testing.T) {
+func Test_addHash(t *testing.T) {
 	sampleElement := Element{
 		ID:      "test",
 		Formats: []string{"osm.pbf"},
@@ -242,7 +345,7 @@ func TestElement_addHash(t *testing.T) {
 				Parent:  tt.fields.Parent,
 			}
 
-			e.addHash(tt.args.myel)
+			addHash(e, tt.args.myel)
 			if !reflect.DeepEqual(*e, tt.wantElement) {
 				t.Errorf("addHash(%v) e=%v, want %v", tt.args.myel, *e, tt.wantElement)
 			}
@@ -279,14 +382,14 @@ func TestExt_parseOSMfr(t *testing.T) {
 			args:   args{doc: f(osmfrValidHTML1, "http://download.openstreetmap.fr/extracts/")},
 			want1:  true,
 			want: ElementSlice{
-				"africa":          {ID: "africa", Meta: false, Name: "africa", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: ""},
-				"asia":            {ID: "asia", Meta: false, Name: "asia", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: ""},
-				"central-america": {ID: "central-america", Meta: false, Name: "central-america", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: ""},
-				"europe":          {ID: "europe", Meta: false, Name: "europe", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: ""},
-				"north-america":   {ID: "north-america", Meta: false, Name: "north-america", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: ""},
-				"oceania":         {ID: "oceania", Meta: false, Name: "oceania", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: ""},
-				"russia":          {ID: "russia", Meta: false, Name: "russia", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: ""},
-				"south-america":   {ID: "south-america", Meta: false, Name: "south-america", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: ""},
+				"africa":          {ID: "africa", Meta: false, Name: "africa", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: "", Info: osmfrExtractInfo},
+				"asia":            {ID: "asia", Meta: false, Name: "asia", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: "", Info: osmfrExtractInfo},
+				"central-america": {ID: "central-america", Meta: false, Name: "central-america", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: "", Info: osmfrExtractInfo},
+				"europe":          {ID: "europe", Meta: false, Name: "europe", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: "", Info: osmfrExtractInfo},
+				"north-america":   {ID: "north-america", Meta: false, Name: "north-america", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: "", Info: osmfrExtractInfo},
+				"oceania":         {ID: "oceania", Meta: false, Name: "oceania", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: "", Info: osmfrExtractInfo},
+				"russia":          {ID: "russia", Meta: false, Name: "russia", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: "", Info: osmfrExtractInfo},
+				"south-america":   {ID: "south-america", Meta: false, Name: "south-america", Formats: []string{"osm.pbf", "osm.pbf.md5", "state"}, Parent: "", File: "", Info: osmfrExtractInfo},
 			},
 		},
 		{
@@ -294,11 +397,11 @@ func TestExt_parseOSMfr(t *testing.T) {
 			fields: Ext{Elements: ElementSlice{}},
 			args:   args{doc: f(osmfrValidHTML2, "http://download.openstreetmap.fr/extracts/merge/")},
 			want: ElementSlice{
-				"france_metro_dom_com_nc": {ID: "france_metro_dom_com_nc", File: "", Meta: false, Name: "france_metro_dom_com_nc", Formats: []string{"osm.pbf", "state"}, Parent: "merge"},
-				"france_taaf":             {ID: "france_taaf", File: "", Meta: false, Name: "france_taaf", Formats: []string{"osm.pbf", "state"}, Parent: "merge"},
-				//"israel_and_palestine":    {ID: "israel_and_palestine", File: "", Meta: false, Name: "israel_and_palestine", Formats: []string{"osm.pbf", "state"}, Parent: "merge"},
-				"kiribati": {ID: "kiribati", File: "", Meta: false, Name: "kiribati", Formats: []string{"osm.pbf", "state"}, Parent: "merge"},
-				"fiji":     {ID: "fiji", File: "", Meta: false, Name: "fiji", Formats: []string{"osm.pbf", "state"}, Parent: "merge"},
+				"france_metro_dom_com_nc": {ID: "france_metro_dom_com_nc", File: "", Meta: false, Name: "france_metro_dom_com_nc", Formats: []string{"osm.pbf", "state"}, Parent: "merge", Info: osmfrMergeInfo},
+				"france_taaf":             {ID: "france_taaf", File: "", Meta: false, Name: "france_taaf", Formats: []string{"osm.pbf", "state"}, Parent: "merge", Info: osmfrMergeInfo},
+				//"israel_and_palestine":    {ID: "israel_and_palestine", File: "", Meta: false, Name: "israel_and_palestine", Formats: []string{"osm.pbf", "state"}, Parent: "merge", Info: osmfrMergeInfo},
+				"kiribati": {ID: "kiribati", File: "", Meta: false, Name: "kiribati", Formats: []string{"osm.pbf", "state"}, Parent: "merge", Info: osmfrMergeInfo},
+				"fiji":     {ID: "fiji", File: "", Meta: false, Name: "fiji", Formats: []string{"osm.pbf", "state"}, Parent: "merge", Info: osmfrMergeInfo},
 			},
 			want1: true,
 		}, {
@@ -307,14 +410,14 @@ func TestExt_parseOSMfr(t *testing.T) {
 			args:   args{doc: f(osmfrPolygonJapanValidHTML, "http://download.openstreetmap.fr/polygons/asia/japan/")},
 			want1:  true,
 			want: ElementSlice{
-				"chubu":    {ID: "chubu", Meta: false, Name: "chubu", Formats: []string{"poly"}, Parent: "japan", File: ""},
-				"chugoku":  {ID: "chugoku", Meta: false, Name: "chugoku", Formats: []string{"poly"}, Parent: "japan", File: ""},
-				"hokkaido": {ID: "hokkaido", Meta: false, Name: "hokkaido", Formats: []string{"poly"}, Parent: "japan", File: ""},
-				"kansai":   {ID: "kansai", Meta: false, Name: "kansai", Formats: []string{"poly"}, Parent: "japan", File: ""},
-				"kanto":    {ID: "kanto", Meta: false, Name: "kanto", Formats: []string{"poly"}, Parent: "japan", File: ""},
-				"kyushu":   {ID: "kyushu", Meta: false, Name: "kyushu", Formats: []string{"poly"}, Parent: "japan", File: ""},
-				"shikoku":  {ID: "shikoku", Meta: false, Name: "shikoku", Formats: []string{"poly"}, Parent: "japan", File: ""},
-				"tohoku":   {ID: "tohoku", Meta: false, Name: "tohoku", Formats: []string{"poly"}, Parent: "japan", File: ""},
+				"chubu":    {ID: "chubu", Meta: false, Name: "chubu", Formats: []string{"poly"}, Parent: "japan", File: "", Info: osmfrPolyInfo},
+				"chugoku":  {ID: "chugoku", Meta: false, Name: "chugoku", Formats: []string{"poly"}, Parent: "japan", File: "", Info: osmfrPolyInfo},
+				"hokkaido": {ID: "hokkaido", Meta: false, Name: "hokkaido", Formats: []string{"poly"}, Parent: "japan", File: "", Info: osmfrPolyInfo},
+				"kansai":   {ID: "kansai", Meta: false, Name: "kansai", Formats: []string{"poly"}, Parent: "japan", File: "", Info: osmfrPolyInfo},
+				"kanto":    {ID: "kanto", Meta: false, Name: "kanto", Formats: []string{"poly"}, Parent: "japan", File: "", Info: osmfrPolyInfo},
+				"kyushu":   {ID: "kyushu", Meta: false, Name: "kyushu", Formats: []string{"poly"}, Parent: "japan", File: "", Info: osmfrPolyInfo},
+				"shikoku":  {ID: "shikoku", Meta: false, Name: "shikoku", Formats: []string{"poly"}, Parent: "japan", File: "", Info: osmfrPolyInfo},
+				"tohoku":   {ID: "tohoku", Meta: false, Name: "tohoku", Formats: []string{"poly"}, Parent: "japan", File: "", Info: osmfrPolyInfo},
 			},
 		},
 	}
@@ -443,6 +546,40 @@ func Benchmark_Parser_osmfrPolygonJapanValidHTML(b *testing.B) {
 	}
 }
 
+// geofabrikInternalShikokuHTML is like geofabrikShikokuHTML on osm-internal.download.geofabrik.de
+const geofabrikInternalShikokuHTML = `<html><body>
+<p><a href="../japan.html">[one level up]</a></p>
+<div class="download-main">
+<h2>Shikoku</h2>
+<div class="leftColumn">
+<ul>
+<li><a href="shikoku-latest-internal.osm.pbf">shikoku-latest-internal.osm.pbf</a>, with user and changeset metadata. This file was last modified 11 hours ago and contains all OSM data up to 2019-04-02T19:00:00Z. File size: 61.4 MB; MD5 sum: <a href="shikoku-latest-internal.osm.pbf.md5">0f3a7c0aa4c9e4e2d8f0e5a2bfa4f2a1</a>.</li>
+<li><a href="shikoku-internal.osh.pbf">shikoku-internal.osh.pbf</a>, full history. File size: 112 MB; MD5 sum: <a href="shikoku-internal.osh.pbf.md5">7a5d9a2f1c6b0e4b3a9d2f8e1c0b7a6d</a>.</li>
+<li><a href="shikoku.poly">.poly file</a> that describes the extent of this region.</li>
+<li><a href="shikoku-updates">.osc.gz files</a> that contain all changes in this region</li>
+</ul>
+</div>
+</div>
+</body></html>`
+
+func Test_geofabrikID(t *testing.T) {
+	tests := []struct {
+		link string
+		want string
+	}{
+		{link: "shikoku-latest.osm.pbf", want: "shikoku"},
+		{link: "shikoku-latest-internal.osm.pbf", want: "shikoku"},
+		{link: "district-of-columbia-latest.osm.pbf", want: "district-of-columbia"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.link, func(t *testing.T) {
+			if got := geofabrikID(tt.link); got != tt.want {
+				t.Errorf("geofabrikID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestExt_parseGeofabrik(t *testing.T) {
 	var f func(s string) *goquery.Document //, myUrl string) *goquery.Document
 	f = func(s string) *goquery.Document { //, myUrl string) *goquery.Document {
@@ -470,8 +607,7 @@ func TestExt_parseGeofabrik(t *testing.T) {
 			args:  args{doc: f(geofabrikSouthAmericaHTML)},
 			want1: true,
 			want: ElementSlice{
-				"us":            {ID: "us", File: "", Meta: true, Name: "United States of America", Formats: []string{}, Parent: "north-america"},
-				"south-america": {ID: "south-america", File: "", Meta: false, Name: "South America", Formats: []string{"osm.pbf", "osm.pbf.md5", "osm.bz2", "osm.bz2.md5", "poly", "kml", "state"}, Parent: ""},
+				"south-america": {ID: "south-america", File: "", Meta: false, Name: "South America", Formats: []string{"osm.pbf", "osm.pbf.md5", "osm.bz2", "osm.bz2.md5", "poly", "kml", "state"}, Parent: "", Info: geofabrikPageInfo},
 			},
 		},
 		{
@@ -479,8 +615,7 @@ func TestExt_parseGeofabrik(t *testing.T) {
 			args:  args{doc: f(geofabrikDistrictOfColumbiaHTML)},
 			want1: true,
 			want: ElementSlice{
-				"us":                   {ID: "us", File: "", Meta: true, Name: "United States of America", Formats: []string{}, Parent: "north-america"},
-				"district-of-columbia": {ID: "district-of-columbia", File: "", Meta: false, Name: "District of Columbia", Formats: []string{"osm.pbf", "osm.pbf.md5", "shp.zip", "osm.bz2", "osm.bz2.md5", "poly", "kml", "state"}, Parent: "us"},
+				"district-of-columbia": {ID: "district-of-columbia", File: "", Meta: false, Name: "District of Columbia", Formats: []string{"osm.pbf", "osm.pbf.md5", "shp.zip", "osm.bz2", "osm.bz2.md5", "poly", "kml", "state"}, Parent: "us", Info: geofabrikPageInfoWithShp},
 			},
 		},
 		{
@@ -488,8 +623,18 @@ func TestExt_parseGeofabrik(t *testing.T) {
 			args:  args{doc: f(geofabrikShikokuHTML)},
 			want1: true,
 			want: ElementSlice{
-				"us":      {ID: "us", File: "", Meta: true, Name: "United States of America", Formats: []string{}, Parent: "north-america"},
-				"shikoku": {ID: "shikoku", File: "", Meta: false, Name: "Shikoku", Formats: []string{"osm.pbf", "osm.pbf.md5", "shp.zip", "osm.bz2", "osm.bz2.md5", "poly", "kml", "state"}, Parent: "japan"},
+				"shikoku": {ID: "shikoku", File: "", Meta: false, Name: "Shikoku", Formats: []string{"osm.pbf", "osm.pbf.md5", "shp.zip", "osm.bz2", "osm.bz2.md5", "poly", "kml", "state"}, Parent: "japan", Info: geofabrikPageInfoWithShp},
+			},
+		},
+		{
+			name:  "Parse Geofabrik internal Shikoku",
+			args:  args{doc: f(geofabrikInternalShikokuHTML)},
+			want1: true,
+			want: ElementSlice{
+				"shikoku": {ID: "shikoku", Name: "Shikoku", Formats: []string{"osm.pbf", "osm.pbf.md5", "osh.pbf", "osh.pbf.md5", "poly", "kml", "state"}, Parent: "japan", Info: map[string]FileInfo{
+					"osm.pbf": geofabrikPageInfo["osm.pbf"],
+					"osh.pbf": {Size: 112 << 20},
+				}},
 			},
 		},
 	}
@@ -545,3 +690,58 @@ func Benchmark_Parser_geofabrikShikokuHTML(b *testing.B) {
 		ext.parseGeofabrik(nil, nil, doc)
 	}
 }
+
+func Test_geofabrikInfo(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want FileInfo
+	}{
+		{
+			name: "osm.pbf",
+			text: "monaco-latest.osm.pbf, suitable for Osmium. This file was last modified 11 hours ago and contains all OSM data up to 2019-04-02T19:00:00Z. File size: 61.4 MB; MD5 sum: 0f3a",
+			want: FileInfo{Size: 64382566, Date: time.Date(2019, 4, 2, 19, 0, 0, 0, time.UTC)},
+		},
+		{name: "lowercase unit", text: "File size: 395 kB; MD5 sum: 0f3a", want: FileInfo{Size: 395 << 10}},
+		{name: "size only", text: "monaco-latest-free.shp.zip, yields a number of ESRI compatible shape files when unzipped. File size: 112 MB.", want: FileInfo{Size: 112 << 20}},
+		{name: "nothing", text: ".poly file that describes the extent of this region.", want: FileInfo{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := geofabrikInfo(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("geofabrikInfo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_osmfrInfo(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want FileInfo
+	}{
+		{
+			name: "file",
+			html: `<table><tr><td><img></td><td><a href="fiji.osm.pbf">fiji.osm.pbf</a></td><td align="right">2019-04-02 05:40  </td><td align="right"> 3.9M</td><td>&nbsp;</td></tr></table>`,
+			want: FileInfo{Size: 4089446, Date: time.Date(2019, 4, 2, 5, 40, 0, 0, time.UTC)},
+		},
+		{
+			name: "directory",
+			html: `<table><tr><td><img></td><td><a href="merge/">merge/</a></td><td align="right">2019-04-02 05:47  </td><td align="right">  - </td><td>&nbsp;</td></tr></table>`,
+			want: FileInfo{Date: time.Date(2019, 4, 2, 5, 47, 0, 0, time.UTC)},
+		},
+		{name: "header", html: `<table><tr><th>Name</th></tr></table>`, want: FileInfo{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := osmfrInfo(doc.Find("tr")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("osmfrInfo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ module github.com/julien-noblet/download-geofabrik
 
 require (
 	bou.ke/monkey v1.0.1
+	github.com/BurntSushi/toml v0.3.0
 	github.com/PuerkitoBio/gocrawl v1.0.0
 	github.com/PuerkitoBio/goquery v1.5.0
 	github.com/PuerkitoBio/purell v1.1.1 // indirect
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func Test_handleSignals(t *testing.T) {
+	*fQuiet = true
+	ctx, stop := handleSignals()
+	defer stop()
+	p, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Signal(os.Interrupt); err != nil {
+		t.Skip("can't send SIGINT:", err)
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Errorf("handleSignals() context not cancelled by SIGINT")
+	}
+}
+
+func Test_handleSignals_stop(t *testing.T) {
+	ctx, stop := handleSignals()
+	if ctx.Err() != nil {
+		t.Errorf("handleSignals() context cancelled before any signal")
+	}
+	stop()
+	if ctx.Err() == nil {
+		t.Errorf("stop() must cancel context")
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
+)
+
+// migrateCommand rewrite configFile with the latest version.
+// Includes are not merged.
+func migrateCommand(configFile string) (int, error) {
+	if !fileExist(configFile) {
+		return 0, fmt.Errorf("%s not found", configFile)
+	}
+	content, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		return 0, err
+	}
+	var old struct {
+		Version int `yaml:"version" json:"version" toml:"version"`
+	}
+	if err = geofabrik.UnmarshalConfig(configFile, content, &old); err != nil {
+		return 0, err
+	}
+	c, err := readConfig(configFile) // migrated
+	if err != nil {
+		return 0, err
+	}
+	out, err := geofabrik.MarshalConfig(c, geofabrik.ConfigFormat(configFile, content))
+	if err != nil {
+		return 0, err
+	}
+	return old.Version, ioutil.WriteFile(configFile, out, 0644)
+}
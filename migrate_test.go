@@ -0,0 +1,95 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func Test_migrateConfig_shipped(t *testing.T) {
+	for name := range serviceConfigs {
+		if _, ok := embeddedConfigs[serviceConfigs[name]]; !ok {
+			continue // not shipped
+		}
+		t.Run(name, func(t *testing.T) {
+			content, err := ioutil.ReadFile(serviceConfigs[name])
+			if err != nil {
+				t.Fatal(err)
+			}
+			raw := new(Config)
+			if err := yaml.Unmarshal(content, raw); err != nil {
+				t.Fatal(err)
+			}
+			if raw.Version != geofabrik.ConfigVersion {
+				t.Errorf("%s version = %d, want %d", serviceConfigs[name], raw.Version, geofabrik.ConfigVersion)
+			}
+			c, err := loadConfig(serviceConfigs[name])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(c, raw) {
+				t.Errorf("%s must not need a migration", serviceConfigs[name])
+			}
+		})
+	}
+}
+
+func Test_migrateCommand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	old := filepath.Join(dir, "old.yml")
+	ioutil.WriteFile(old, []byte("baseURL: https://my.base.url\nelements:\n  europe:\n    name: Europe\n"), 0644)
+	oldJSON := filepath.Join(dir, "old.json")
+	ioutil.WriteFile(oldJSON, []byte(`{"baseURL": "https://my.base.url", "elements": {"europe": {"name": "Europe"}}}`), 0644)
+	tooNew := filepath.Join(dir, "new.yml")
+	ioutil.WriteFile(tooNew, []byte("version: 999\nbaseURL: https://my.base.url\n"), 0644)
+	tests := []struct {
+		name        string
+		configFile  string
+		wantVersion int
+		wantErr     bool
+	}{
+		{name: "yaml without version", configFile: old, wantVersion: 0},
+		{name: "json without version", configFile: oldJSON, wantVersion: 0},
+		{name: "already migrated", configFile: old, wantVersion: geofabrik.ConfigVersion},
+		{name: "too new", configFile: tooNew, wantErr: true},
+		{name: "missing file", configFile: filepath.Join(dir, "geofabrik.yml"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := migrateCommand(tt.configFile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("migrateCommand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if tt.name == "too new" && !strings.Contains(err.Error(), "too new") {
+					t.Errorf("migrateCommand() error = %v, want a too new error", err)
+				}
+				return
+			}
+			if got != tt.wantVersion {
+				t.Errorf("migrateCommand() = %v, want %v", got, tt.wantVersion)
+			}
+			c, err := readConfig(tt.configFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.Version != geofabrik.ConfigVersion || c.Elements["europe"].ID != "europe" || !c.Elements["europe"].Meta {
+				t.Errorf("migrateCommand() write %+v", c)
+			}
+			content, _ := ioutil.ReadFile(tt.configFile)
+			if geofabrik.ConfigFormat(tt.configFile, content) != geofabrik.ConfigFormat(tt.configFile, nil) {
+				t.Errorf("migrateCommand() change format of %s", tt.configFile)
+			}
+		})
+	}
+}
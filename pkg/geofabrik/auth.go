@@ -0,0 +1,343 @@
+package geofabrik
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Auth are credentials sent to some hosts, like a private mirror.
+// Token is used first, then User and Password, then Netrc.
+// Cookies are sent with them.
+type Auth struct {
+	Hosts    []string `yaml:"hosts,omitempty" json:"hosts,omitempty" toml:"hosts,omitempty"`          // default is the host of baseURL
+	User     string   `yaml:"user,omitempty" json:"user,omitempty" toml:"user,omitempty"`             // basic auth
+	Password string   `yaml:"password,omitempty" json:"password,omitempty" toml:"password,omitempty"` // with User
+	Token    string   `yaml:"token,omitempty" json:"token,omitempty" toml:"token,omitempty"`          // bearer token
+	Netrc    bool     `yaml:"netrc,omitempty" json:"netrc,omitempty" toml:"netrc,omitempty"`          // use $NETRC or ~/.netrc
+	Cookies  string   `yaml:"cookies,omitempty" json:"cookies,omitempty" toml:"cookies,omitempty"`    // cookie file, like cookies.txt of curl or wget
+}
+
+// WithHosts give a copy of a using host of baseURL if it have no Hosts.
+func (a Auth) WithHosts(baseURL string) Auth {
+	if len(a.Hosts) > 0 {
+		return a
+	}
+	if u, err := url.Parse(baseURL); err == nil && u.Host != "" {
+		a.Hosts = []string{u.Host}
+	}
+	return a
+}
+
+// match tell if a is sent to u.
+// Hosts may have a port, if not any port match.
+func (a *Auth) match(u *url.URL) bool {
+	for _, host := range a.Hosts {
+		if strings.EqualFold(host, u.Host) || strings.EqualFold(host, u.Hostname()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Auths give credentials of c and of its formats.
+// Formats credentials are used first, they default to host of format baseurl.
+func (c *Config) Auths() []Auth {
+	var auths []Auth
+	for _, id := range formatIDs(c.Formats) {
+		f := c.Formats[id]
+		if f.Auth == nil {
+			continue
+		}
+		baseURL := f.BaseURL
+		if baseURL == "" {
+			baseURL = c.BaseURL
+		}
+		auths = append(auths, f.Auth.WithHosts(baseURL))
+	}
+	for _, a := range c.Auth {
+		auths = append(auths, a.WithHosts(c.BaseURL))
+	}
+	return auths
+}
+
+// expandHome replace ~/ at the start of file with the home directory.
+func expandHome(file string) string {
+	if home := os.Getenv("HOME"); home != "" && strings.HasPrefix(file, "~/") {
+		return filepath.Join(home, file[2:])
+	}
+	return file
+}
+
+// netrcFile give $NETRC or ~/.netrc.
+func netrcFile() string {
+	if file := os.Getenv("NETRC"); file != "" {
+		return file
+	}
+	return expandHome("~/.netrc")
+}
+
+// netrcLogin is a login and password of a netrc file.
+type netrcLogin struct {
+	login, password string
+}
+
+// readNetrc read logins of a netrc file by machine, "" is the default.
+// A missing file have no logins.
+func readNetrc(file string) (map[string]netrcLogin, error) {
+	logins := make(map[string]netrcLogin)
+	f, err := os.Open(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return logins, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	var tokens []string
+	scanner := bufio.NewScanner(f)
+	inMacro := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if inMacro { // macdef end with an empty line
+			inMacro = strings.TrimSpace(line) != ""
+			continue
+		}
+		fields := strings.Fields(line)
+		for i, field := range fields {
+			if field == "macdef" {
+				fields, inMacro = fields[:i], true
+				break
+			}
+		}
+		tokens = append(tokens, fields...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	machine, inMachine := "", false
+	for i := 0; i < len(tokens); i++ {
+		value := ""
+		if i+1 < len(tokens) {
+			value = tokens[i+1]
+		}
+		switch tokens[i] {
+		case "machine":
+			machine, inMachine = value, true
+			i++
+		case "default":
+			machine, inMachine = "", true
+		case "login", "password", "account":
+			i++
+			if !inMachine {
+				continue
+			}
+			login := logins[machine]
+			if tokens[i-1] == "login" {
+				login.login = value
+			} else if tokens[i-1] == "password" {
+				login.password = value
+			}
+			logins[machine] = login
+		}
+	}
+	return logins, nil
+}
+
+// readCookies read a cookie file in Netscape format, like cookies.txt of curl or wget.
+// Lines can also be HTTP cookies, like "name=value; path=/" written by
+// oauth_cookie_client.py of Geofabrik, those without domain have no Domain.
+// Expired cookies are skipped.
+func readCookies(file string, now time.Time) ([]*http.Cookie, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var cookies []*http.Cookie
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		line = strings.TrimPrefix(line, "#HttpOnly_")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) == 1 && strings.Contains(line, "=") {
+			cookies = append(cookies, httpCookies(line, now)...)
+			continue
+		}
+		if len(fields) != 7 {
+			return nil, fmt.Errorf("%s:%d is not a cookie, cookie files use Netscape or HTTP format", file, n)
+		}
+		expires, err := strconv.ParseInt(fields[4], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d wrong expiration %s", file, n, fields[4])
+		}
+		if expires != 0 && time.Unix(expires, 0).Before(now) {
+			continue
+		}
+		domain := strings.ToLower(fields[0])
+		if strings.EqualFold(fields[1], "TRUE") && !strings.HasPrefix(domain, ".") {
+			domain = "." + domain // subdomains too
+		}
+		cookies = append(cookies, &http.Cookie{
+			Domain: domain,
+			Path:   fields[2],
+			Secure: strings.EqualFold(fields[3], "TRUE"),
+			Name:   fields[5],
+			Value:  fields[6],
+		})
+	}
+	return cookies, scanner.Err()
+}
+
+// httpCookies read cookies of a Set-Cookie header line.
+func httpCookies(line string, now time.Time) []*http.Cookie {
+	var cookies []*http.Cookie
+	res := http.Response{Header: http.Header{"Set-Cookie": {line}}}
+	for _, cookie := range res.Cookies() {
+		if !cookie.Expires.IsZero() && cookie.Expires.Before(now) {
+			continue
+		}
+		if cookie.Domain != "" && !strings.HasPrefix(cookie.Domain, ".") {
+			cookie.Domain = "." + cookie.Domain // like browsers do
+		}
+		cookies = append(cookies, &http.Cookie{Domain: strings.ToLower(cookie.Domain), Path: cookie.Path, Secure: cookie.Secure, Name: cookie.Name, Value: cookie.Value})
+	}
+	return cookies
+}
+
+// hostCookies give cookies with a copy of cookies without domain for each host.
+// They are only sent to this host, like a cookie set by it.
+func hostCookies(cookies []*http.Cookie, hosts []string) []*http.Cookie {
+	var all []*http.Cookie
+	for _, cookie := range cookies {
+		if cookie.Domain != "" {
+			all = append(all, cookie)
+			continue
+		}
+		for _, host := range hosts {
+			hostCookie := *cookie
+			hostCookie.Domain = strings.ToLower((&url.URL{Host: host}).Hostname())
+			all = append(all, &hostCookie)
+		}
+	}
+	return all
+}
+
+// cookieMatch tell if cookie is sent to u.
+// Cookies without path match any path, those without domain match none.
+func cookieMatch(cookie *http.Cookie, u *url.URL) bool {
+	host := strings.ToLower(u.Hostname())
+	if cookie.Secure && u.Scheme != "https" {
+		return false
+	}
+	if !strings.HasPrefix(u.Path, cookie.Path) && !(u.Path == "" && cookie.Path == "/") {
+		return false
+	}
+	if cookie.Domain == "" {
+		return false
+	}
+	if strings.HasPrefix(cookie.Domain, ".") {
+		return host == cookie.Domain[1:] || strings.HasSuffix(host, cookie.Domain)
+	}
+	return host == cookie.Domain
+}
+
+// authRule is an Auth with its files loaded.
+type authRule struct {
+	Auth
+	netrc   map[string]netrcLogin
+	cookies []*http.Cookie
+}
+
+// AuthTransport add credentials of Auths matching the host of requests.
+// The first Authorization found is used, cookies of all of them are sent.
+// Other hosts, including redirections to them, don't get any credentials.
+// Authorization header already set is kept.
+type AuthTransport struct {
+	Base  http.RoundTripper // default is http.DefaultTransport
+	rules []authRule
+}
+
+// NewAuthTransport make an AuthTransport of auths over base.
+// Netrc and cookie files are read now, cookies without domain are sent to Hosts of their Auth.
+func NewAuthTransport(base http.RoundTripper, auths []Auth) (*AuthTransport, error) {
+	t := &AuthTransport{Base: base}
+	for _, a := range auths {
+		rule := authRule{Auth: a}
+		var err error
+		if a.Netrc {
+			if rule.netrc, err = readNetrc(netrcFile()); err != nil {
+				return nil, fmt.Errorf("Can't read netrc file: %v", err)
+			}
+		}
+		if a.Cookies != "" {
+			if rule.cookies, err = readCookies(expandHome(a.Cookies), time.Now()); err != nil {
+				return nil, fmt.Errorf("Can't read cookie file: %v", err)
+			}
+			rule.cookies = hostCookies(rule.cookies, a.Hosts)
+		}
+		t.rules = append(t.rules, rule)
+	}
+	return t, nil
+}
+
+// RoundTrip send req with credentials of its host.
+func (t *AuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	var authReq *http.Request
+	for i := range t.rules {
+		if !t.rules[i].match(req.URL) {
+			continue
+		}
+		if authReq == nil {
+			authReq = new(http.Request) // RoundTrip must not modify req
+			*authReq = *req
+			authReq.Header = make(http.Header, len(req.Header)+2)
+			for k, v := range req.Header {
+				authReq.Header[k] = v
+			}
+		}
+		t.rules[i].apply(authReq)
+	}
+	if authReq == nil {
+		return base.RoundTrip(req)
+	}
+	return base.RoundTrip(authReq)
+}
+
+// apply add credentials of r to req, Authorization only if not set yet.
+func (r *authRule) apply(req *http.Request) {
+	if req.Header.Get("Authorization") == "" {
+		switch {
+		case r.Token != "":
+			req.Header.Set("Authorization", "Bearer "+r.Token)
+		case r.User != "":
+			req.SetBasicAuth(r.User, r.Password)
+		case r.netrc != nil:
+			login, ok := r.netrc[req.URL.Hostname()]
+			if !ok {
+				login, ok = r.netrc[""]
+			}
+			if ok && login.login != "" {
+				req.SetBasicAuth(login.login, login.password)
+			}
+		}
+	}
+	for _, cookie := range r.cookies {
+		if cookieMatch(cookie, req.URL) {
+			req.AddCookie(&http.Cookie{Name: cookie.Name, Value: cookie.Value})
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package geofabrik
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAuth_WithHosts(t *testing.T) {
+	tests := []struct {
+		name    string
+		auth    Auth
+		baseURL string
+		want    []string
+	}{
+		{name: "default host", baseURL: "https://mirror.example.com:8443/osm", want: []string{"mirror.example.com:8443"}},
+		{name: "hosts kept", auth: Auth{Hosts: []string{"a.example.com"}}, baseURL: "https://mirror.example.com", want: []string{"a.example.com"}},
+		{name: "no baseURL", baseURL: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.auth.WithHosts(tt.baseURL); !reflect.DeepEqual(got.Hosts, tt.want) {
+				t.Errorf("Auth.WithHosts() = %v, want %v", got.Hosts, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_Auths(t *testing.T) {
+	c := &Config{
+		BaseURL: "https://download.geofabrik.de",
+		Formats: map[string]Format{
+			"osh.pbf": {ID: "osh.pbf", Loc: ".osh.pbf", BaseURL: "https://osm-internal.download.geofabrik.de", Auth: &Auth{Cookies: "cookies.txt"}},
+			"osm.pbf": {ID: "osm.pbf", Loc: "-latest.osm.pbf"},
+			"poly":    {ID: "poly", Loc: ".poly", Auth: &Auth{Token: "secret"}},
+		},
+		Auth: []Auth{{User: "me", Password: "secret"}, {Netrc: true, Hosts: []string{"mirror.example.com"}}},
+	}
+	want := []Auth{
+		{Hosts: []string{"osm-internal.download.geofabrik.de"}, Cookies: "cookies.txt"},
+		{Hosts: []string{"download.geofabrik.de"}, Token: "secret"},
+		{Hosts: []string{"download.geofabrik.de"}, User: "me", Password: "secret"},
+		{Hosts: []string{"mirror.example.com"}, Netrc: true},
+	}
+	if got := c.Auths(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Config.Auths() = %+v, want %+v", got, want)
+	}
+}
+
+func TestMergeConfig_auth(t *testing.T) {
+	c := &Config{BaseURL: "https://download.geofabrik.de", Auth: []Auth{{Token: "public"}}}
+	overlay := &Config{BaseURL: "https://mirror.example.com", Auth: []Auth{{Token: "private"}}}
+	if err := MergeConfig(c, overlay, "mirror.yml"); err != nil {
+		t.Fatal(err)
+	}
+	want := []Auth{{Hosts: []string{"download.geofabrik.de"}, Token: "public"}, {Hosts: []string{"mirror.example.com"}, Token: "private"}}
+	if got := c.Auths(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeConfig() auths = %+v, want %+v", got, want)
+	}
+}
+
+func Test_readNetrc(t *testing.T) {
+	dir, err := ioutil.TempDir("", "geofabrik-netrc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "netrc")
+	content := `machine mirror.example.com login me password secret
+macdef init
+cd /pub
+login nobody
+
+machine other.example.com
+	login other
+	account acct
+	password pass
+default login anonymous password mail@example.com
+`
+	if err := ioutil.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readNetrc(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]netrcLogin{
+		"mirror.example.com": {"me", "secret"},
+		"other.example.com":  {"other", "pass"},
+		"":                   {"anonymous", "mail@example.com"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readNetrc() = %v, want %v", got, want)
+	}
+	if got, err := readNetrc(filepath.Join(dir, "missing")); err != nil || len(got) != 0 {
+		t.Errorf("readNetrc() of a missing file = %v, %v, want no logins", got, err)
+	}
+}
+
+func Test_readCookies(t *testing.T) {
+	dir, err := ioutil.TempDir("", "geofabrik-cookies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	now := time.Unix(1500000000, 0)
+	tests := []struct {
+		name    string
+		content string
+		want    []*http.Cookie
+		wantErr bool
+	}{
+		{
+			name: "cookies",
+			content: "# Netscape HTTP Cookie File\n\n" +
+				".geofabrik.de\tTRUE\t/\tTRUE\t0\tgf_download_oauth\tsession\n" +
+				"#HttpOnly_osm-internal.download.geofabrik.de\tFALSE\t/\tFALSE\t1600000000\tid\t42\n" +
+				"mirror.example.com\tTRUE\t/osm\tFALSE\t1400000000\texpired\tyes\n",
+			want: []*http.Cookie{
+				{Domain: ".geofabrik.de", Path: "/", Secure: true, Name: "gf_download_oauth", Value: "session"},
+				{Domain: "osm-internal.download.geofabrik.de", Path: "/", Name: "id", Value: "42"},
+			},
+		},
+		{
+			name: "http cookies",
+			content: "gf_download_oauth=\"login|2019-04-02|abc\"; Path=/; HttpOnly\n" +
+				"id=42; Domain=geofabrik.de; Secure\n" +
+				"old=1; Expires=Wed, 21 Oct 2015 07:28:00 GMT\n",
+			want: []*http.Cookie{
+				{Path: "/", Name: "gf_download_oauth", Value: "login|2019-04-02|abc"},
+				{Domain: ".geofabrik.de", Secure: true, Name: "id", Value: "42"},
+			},
+		},
+		{name: "not a cookie file", content: "gf_download_oauth session\n", wantErr: true},
+		{name: "wrong expiration", content: "geofabrik.de\tFALSE\t/\tFALSE\tnever\tid\t42\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(dir, "cookies.txt")
+			if err := ioutil.WriteFile(file, []byte(tt.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+			got, err := readCookies(file, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readCookies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("readCookies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cookieMatch(t *testing.T) {
+	domain := &http.Cookie{Domain: ".geofabrik.de", Path: "/", Name: "a"}
+	host := &http.Cookie{Domain: "download.geofabrik.de", Path: "/europe", Secure: true, Name: "b"}
+	tests := []struct {
+		name   string
+		cookie *http.Cookie
+		url    string
+		want   bool
+	}{
+		{name: "no domain", cookie: &http.Cookie{Name: "c"}, url: "http://osm-internal.download.geofabrik.de/europe", want: false},
+		{name: "host only", cookie: &http.Cookie{Domain: "osm-internal.download.geofabrik.de", Name: "c"}, url: "http://osm-internal.download.geofabrik.de/europe", want: true},
+		{name: "host only subdomain", cookie: &http.Cookie{Domain: "download.geofabrik.de", Name: "c"}, url: "http://osm-internal.download.geofabrik.de/europe", want: false},
+		{name: "domain", cookie: domain, url: "https://geofabrik.de/", want: true},
+		{name: "subdomain", cookie: domain, url: "http://osm-internal.download.geofabrik.de/europe", want: true},
+		{name: "no path", cookie: domain, url: "http://geofabrik.de", want: true},
+		{name: "other domain", cookie: domain, url: "https://notgeofabrik.de/", want: false},
+		{name: "host", cookie: host, url: "https://download.geofabrik.de/europe/monaco.poly", want: true},
+		{name: "subdomain of host", cookie: host, url: "https://a.download.geofabrik.de/europe/monaco.poly", want: false},
+		{name: "other path", cookie: host, url: "https://download.geofabrik.de/asia/nepal.poly", want: false},
+		{name: "not secure", cookie: host, url: "http://download.geofabrik.de/europe/monaco.poly", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, _ := url.Parse(tt.url)
+			if got := cookieMatch(tt.cookie, u); got != tt.want {
+				t.Errorf("cookieMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_hostCookies(t *testing.T) {
+	cookies := []*http.Cookie{
+		{Path: "/", Name: "gf_download_oauth", Value: "login"},
+		{Domain: ".geofabrik.de", Name: "id", Value: "42"},
+	}
+	want := []*http.Cookie{
+		{Domain: "osm-internal.download.geofabrik.de", Path: "/", Name: "gf_download_oauth", Value: "login"},
+		{Domain: "127.0.0.1", Path: "/", Name: "gf_download_oauth", Value: "login"},
+		{Domain: ".geofabrik.de", Name: "id", Value: "42"},
+	}
+	if got := hostCookies(cookies, []string{"OSM-internal.download.geofabrik.de", "127.0.0.1:8080"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("hostCookies() = %+v, want %+v", got, want)
+	}
+}
+
+func TestAuthTransport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "geofabrik-auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	netrc := filepath.Join(dir, "netrc")
+	cookies := filepath.Join(dir, "cookies.txt")
+	if err := ioutil.WriteFile(netrc, []byte("machine 127.0.0.1 login me password netrc\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(cookies, []byte("127.0.0.1\tFALSE\t/\tFALSE\t0\tgf_download_oauth\tsession\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	httpCookies := filepath.Join(dir, "http-cookies.txt")
+	if err := ioutil.WriteFile(httpCookies, []byte("gf_download_oauth=login; Path=/\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv("NETRC", os.Getenv("NETRC"))
+	os.Setenv("NETRC", netrc)
+	echo := func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s|%s", r.Header.Get("Authorization"), r.Header.Get("Cookie"))
+	}
+	server := httptest.NewServer(http.HandlerFunc(echo))
+	defer server.Close()
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, server.URL+"/redirected", http.StatusFound)
+			return
+		}
+		echo(w, r)
+	}))
+	defer other.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+	tests := []struct {
+		name   string
+		auth   Auth
+		url    string
+		header string
+		want   string
+	}{
+		{name: "basic", auth: Auth{Hosts: []string{host}, User: "me", Password: "secret"}, url: server.URL, want: "Basic bWU6c2VjcmV0|"},
+		{name: "bearer", auth: Auth{Hosts: []string{host}, Token: "t0ken", User: "me"}, url: server.URL, want: "Bearer t0ken|"},
+		{name: "netrc", auth: Auth{Hosts: []string{"127.0.0.1"}, Netrc: true}, url: server.URL, want: "Basic bWU6bmV0cmM=|"},
+		{name: "cookies", auth: Auth{Hosts: []string{host}, Cookies: cookies}, url: server.URL, want: "|gf_download_oauth=session"},
+		{name: "http cookies", auth: Auth{Hosts: []string{host}, Cookies: httpCookies}, url: server.URL, want: "|gf_download_oauth=login"},
+		{name: "http cookies other host", auth: Auth{Hosts: []string{host}, Cookies: httpCookies}, url: other.URL, want: "|"},
+		{name: "Authorization kept", auth: Auth{Hosts: []string{host}, Token: "t0ken"}, url: server.URL, header: "Bearer mine", want: "Bearer mine|"},
+		{name: "other host", auth: Auth{Hosts: []string{host}, Token: "t0ken"}, url: other.URL, want: "|"},
+		{name: "other port", auth: Auth{Hosts: []string{"127.0.0.1:1"}, Token: "t0ken"}, url: server.URL, want: "|"},
+		{name: "redirected from other host", auth: Auth{Hosts: []string{host}, Token: "t0ken"}, url: other.URL + "/redirect", want: "Bearer t0ken|"},
+		{name: "not redirected to other host", auth: Auth{Hosts: []string{strings.TrimPrefix(other.URL, "http://")}, Token: "t0ken"}, url: other.URL + "/redirect", want: "|"},
+	}
+	merged := []struct {
+		name  string
+		auths []Auth
+		want  string
+	}{
+		{name: "token and cookies", auths: []Auth{{Hosts: []string{host}, Cookies: cookies}, {Hosts: []string{host}, Token: "t0ken"}}, want: "Bearer t0ken|gf_download_oauth=session"},
+		{name: "first Authorization", auths: []Auth{{Hosts: []string{host}, User: "me", Password: "secret"}, {Hosts: []string{host}, Token: "t0ken"}}, want: "Basic bWU6c2VjcmV0|"},
+		{name: "cookies of all", auths: []Auth{{Hosts: []string{host}, Cookies: cookies}, {Hosts: []string{host}, Cookies: httpCookies}}, want: "|gf_download_oauth=session; gf_download_oauth=login"},
+	}
+	for _, tt := range merged {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, err := NewAuthTransport(nil, tt.auths)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := (&http.Client{Transport: transport}).Get(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			if body, _ := ioutil.ReadAll(res.Body); string(body) != tt.want {
+				t.Errorf("AuthTransport send %q, want %q", body, tt.want)
+			}
+		})
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, err := NewAuthTransport(nil, []Auth{tt.auth})
+			if err != nil {
+				t.Fatal(err)
+			}
+			req, err := http.NewRequest("GET", tt.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			res, err := (&http.Client{Transport: transport}).Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			if body, _ := ioutil.ReadAll(res.Body); string(body) != tt.want {
+				t.Errorf("AuthTransport send %q, want %q", body, tt.want)
+			}
+			if req.Header.Get("Authorization") != tt.header {
+				t.Errorf("AuthTransport must not change requests")
+			}
+		})
+	}
+	if _, err := NewAuthTransport(nil, []Auth{{Cookies: filepath.Join(dir, "missing.txt")}}); err == nil {
+		t.Errorf("NewAuthTransport() with a missing cookie file, want an error")
+	}
+}
@@ -0,0 +1,149 @@
+package geofabrik
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Config structure handle all elements.
+// It also contain the BaseURL and Formats...
+type Config struct {
+	Version   int                `yaml:"version,omitempty" json:"version,omitempty" toml:"version,omitzero"` // layout version, see ConfigVersion
+	BaseURL   string             `yaml:"baseURL" json:"baseURL" toml:"baseURL"`
+	Include   []string           `yaml:"include,omitempty" json:"include,omitempty" toml:"include,omitempty"` // merged under this config
+	Generated time.Time          `yaml:"generated,omitempty" json:"generated" toml:"generated"`               // when generate was run
+	Formats   map[string]Format  `yaml:"formats" json:"formats" toml:"formats"`
+	Elements  map[string]Element `yaml:"elements" json:"elements" toml:"elements"`
+	Auth      []Auth             `yaml:"auth,omitempty" json:"auth,omitempty" toml:"auth,omitempty"` // credentials of the service, see Auths
+}
+
+// Format is a kind of file, like osm.pbf or poly.
+type Format struct {
+	ID       string `yaml:"ext" json:"ext" toml:"ext"`
+	Loc      string `yaml:"loc" json:"loc" toml:"loc"`
+	BasePath string `yaml:"basepath,omitempty" json:"basepath,omitempty" toml:"basepath,omitempty"`
+	BaseURL  string `yaml:"baseurl,omitempty" json:"baseurl,omitempty" toml:"baseurl,omitempty"`
+	Auth     *Auth  `yaml:"auth,omitempty" json:"auth,omitempty" toml:"auth,omitempty"` // credentials of this format only
+}
+
+// formatIDs give sorted IDs of formats.
+func formatIDs(formats map[string]Format) []string {
+	ids := make([]string, 0, len(formats))
+	for id := range formats {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Checksums are checksums extensions, like osm.pbf.md5
+var Checksums = []string{"md5"}
+
+// IsHashable tell if format have a checksum format in c.
+// It also give the checksum format and its extension.
+func (c *Config) IsHashable(format string) (bool, string, string) {
+	if _, ok := c.Formats[format]; ok {
+		for _, h := range Checksums {
+			hash := format + "." + h
+			if _, ok := c.Formats[hash]; ok {
+				return true, hash, h
+			}
+		}
+	}
+	return false, "", ""
+}
+
+// MergeConfig add or replace formats and elements of overlay in c.
+// BaseURL of c is kept if set, overlays should use baseurl of their formats.
+// Credentials of overlay are added, with the host of its BaseURL by default.
+// Replacing an element with another parent is an error, see CanMerge.
+func MergeConfig(c *Config, overlay *Config, name string) error {
+	if c.BaseURL == "" {
+		c.BaseURL = overlay.BaseURL
+	}
+	for _, a := range overlay.Auth {
+		c.Auth = append(c.Auth, a.WithHosts(overlay.BaseURL))
+	}
+	if c.Formats == nil {
+		c.Formats = make(map[string]Format)
+	}
+	for k, f := range overlay.Formats {
+		c.Formats[k] = f
+	}
+	if c.Elements == nil {
+		c.Elements = make(map[string]Element)
+	}
+	ids := make([]string, 0, len(overlay.Elements))
+	for id := range overlay.Elements {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		element := overlay.Elements[id]
+		if existing, ok := c.Elements[id]; ok {
+			if err := CanMerge(&existing, &element); err != nil {
+				return fmt.Errorf("%s: %s %v", name, id, err)
+			}
+		}
+		c.Elements[id] = element
+	}
+	return nil
+}
+
+// UnmarshalConfig read content of configFile into v, using the format of configFile.
+func UnmarshalConfig(configFile string, content []byte, v interface{}) error {
+	switch ConfigFormat(configFile, content) {
+	case "json":
+		return json.Unmarshal(content, v)
+	case "toml":
+		return toml.Unmarshal(content, v)
+	}
+	return yaml.Unmarshal(content, v)
+}
+
+// tomlLine match a TOML table or key = value line.
+var tomlLine = regexp.MustCompile(`(?m)^\s*(\[[^\]]+\]|[\w."-]+\s*=)`)
+
+// ConfigFormat give the format of a config file: "yaml", "json" or "toml".
+// Format is found with the extension of filename, or with content if unknown.
+func ConfigFormat(filename string, content []byte) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		return "json"
+	case ".toml":
+		return "toml"
+	case ".yml", ".yaml":
+		return "yaml"
+	}
+	content = bytes.TrimSpace(content)
+	if bytes.HasPrefix(content, []byte("{")) {
+		return "json"
+	}
+	if tomlLine.Match(content) {
+		return "toml"
+	}
+	return "yaml"
+}
+
+// MarshalConfig write c in format.
+func MarshalConfig(c *Config, format string) ([]byte, error) {
+	switch format {
+	case "json":
+		out, err := json.MarshalIndent(c, "", "  ")
+		return append(out, '\n'), err
+	case "toml":
+		var buf bytes.Buffer
+		err := toml.NewEncoder(&buf).Encode(c)
+		return buf.Bytes(), err
+	}
+	return yaml.Marshal(c)
+}
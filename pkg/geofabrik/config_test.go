@@ -0,0 +1,114 @@
+package geofabrik
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestConfigFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		want     string
+	}{
+		{name: "yml", filename: "geofabrik.yml", want: "yaml"},
+		{name: "yaml", filename: "geofabrik.YAML", want: "yaml"},
+		{name: "json", filename: "geofabrik.json", want: "json"},
+		{name: "toml", filename: "/etc/geofabrik.toml", want: "toml"},
+		{name: "json content", filename: "config", content: "\n{\"baseURL\": \"https://my.base.url\"}", want: "json"},
+		{name: "toml content", filename: "config", content: "baseURL = \"https://my.base.url\"\n[formats]\n", want: "toml"},
+		{name: "yaml content", filename: "config", content: "baseURL: https://my.base.url\nformats:\n", want: "yaml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConfigFormat(tt.filename, []byte(tt.content)); got != tt.want {
+				t.Errorf("ConfigFormat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshalConfig_roundTrip(t *testing.T) {
+	for _, configFile := range []string{"../../geofabrik.yml", "../../openstreetmap.fr.yml", "../../gislab.yml"} {
+		want, err := loadConfig(configFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want.Generated = time.Date(2019, 4, 10, 12, 0, 0, 0, time.UTC)
+		for _, format := range []string{"json", "toml", "yaml"} {
+			t.Run(configFile+" to "+format, func(t *testing.T) {
+				out, err := MarshalConfig(want, format)
+				if err != nil {
+					t.Fatalf("MarshalConfig() error = %v", err)
+				}
+				got := new(Config)
+				switch format {
+				case "json":
+					err = json.Unmarshal(out, got)
+				case "toml":
+					err = toml.Unmarshal(out, got)
+				default:
+					err = yaml.Unmarshal(out, got)
+				}
+				if err != nil {
+					t.Fatalf("can't read %s: %v", format, err)
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s round trip is not lossless", format)
+				}
+			})
+		}
+	}
+}
+
+func TestConfig_IsHashable(t *testing.T) {
+	type args struct {
+		format string
+		file   string
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  bool
+		want1 string
+		want2 string
+	}{
+		// TODO: Add test cases.
+		{name: "Test is osm.pbf is hashable", args: args{format: "osm.pbf", file: "../../geofabrik.yml"}, want: true, want1: "osm.pbf.md5", want2: "md5"},
+		{name: "Test is kml is hashable", args: args{format: "kml", file: "../../geofabrik.yml"}, want: false, want1: "", want2: ""},
+	}
+	for _, tt := range tests {
+		c, err := loadConfig(tt.args.file)
+		if err != nil {
+			t.Error(err)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2 := c.IsHashable(tt.args.format)
+			if got != tt.want {
+				t.Errorf("Config.IsHashable() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("Config.IsHashable() got1 = %v, want %v", got1, tt.want1)
+			}
+			if got2 != tt.want2 {
+				t.Errorf("Config.IsHashable() got2 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+}
+
+func Benchmark_IsHashable_geofabrik_yml(b *testing.B) {
+	// run the Fib function b.N times
+	c, _ := loadConfig("../../geofabrik.yml")
+	for n := 0; n < b.N; n++ {
+		for f := range c.Formats {
+			c.IsHashable(f)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package geofabrik
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NotFoundError is returned by DownloadFile when server return 404.
+// It usually means the config file is outdated.
+type NotFoundError struct {
+	URL string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("Error while downloading %v, server return code %d\nPlease use 'download-geofabrik generate' to re-create your yml file", e.URL, 404)
+}
+
+// Cause give the kind of e, it's ErrHTTPStatus.
+func (e NotFoundError) Cause() error {
+	return ErrHTTPStatus
+}
+
+// Progress is told about downloaded bytes, like a progress bar.
+type Progress interface {
+	io.Writer
+	Finish()
+}
+
+// DownloadOptions change how files are downloaded.
+type DownloadOptions struct {
+	OutputDir string // default is current directory
+	Check     bool   // control with checksum when available
+	DryRun    bool   // only log what would be downloaded
+	Resume    bool   // keep partial files and continue them, see DownloadFile
+	Segments  int    // download each file with this number of ranges in parallel, see DownloadFile
+	// Progress is called with the size of each file, it may return nil.
+	Progress func(size int64) Progress
+}
+
+// LocalFile give the path where element.format is downloaded in dir.
+func LocalFile(dir string, element string, format string) string {
+	return filepath.Join(dir, element+"."+format)
+}
+
+// Download download formats of element in opts.OutputDir.
+func (cl *Client) Download(ctx context.Context, element string, formats []string, opts DownloadOptions) error {
+	for _, format := range formats {
+		if err := cl.DownloadFormat(ctx, element, format, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DownloadFormat download element.format in opts.OutputDir.
+// With opts.Check, an existing file is kept if its checksum match.
+func (cl *Client) DownloadFormat(ctx context.Context, element string, format string, opts DownloadOptions) error {
+	start := time.Now()
+	err := cl.downloadFormat(ctx, element, format, opts)
+	if err != nil {
+		myURL, _ := cl.URL(element, format)
+		cl.event(Event{Type: EventError, Element: element, Format: format, URL: myURL, Duration: time.Since(start), Err: err})
+	}
+	return err
+}
+
+func (cl *Client) downloadFormat(ctx context.Context, element string, format string, opts DownloadOptions) error {
+	myURL, err := cl.URL(element, format)
+	if err != nil {
+		return err
+	}
+	e := Event{Element: element, Format: format, URL: myURL}
+	filename := LocalFile(opts.OutputDir, element, format)
+	if hashable, _, _ := cl.Config.IsHashable(format); opts.DryRun || !opts.Check || !hashable {
+		return cl.downloadWithEvents(ctx, e, filename, opts)
+	}
+	if fileExist(filename) {
+		ok, err := cl.Verify(ctx, element, format, opts)
+		if err != nil {
+			return err
+		}
+		if ok {
+			cl.logf("Checksum match, no download!")
+			e.Type = EventSkippedUpToDate
+			e.Bytes = fileSize(filename)
+			cl.event(e)
+			return nil
+		}
+		cl.logf("Checksum mismatch, re-downloading %s", filename)
+	}
+	if err = cl.downloadWithEvents(ctx, e, filename, opts); err != nil {
+		return err
+	}
+	ok, err := cl.Verify(ctx, element, format, opts)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return Errorf(ErrChecksumMismatch, "Checksum mismatch, please re-download %s", filename)
+	}
+	return nil
+}
+
+// networkReader give ErrNetwork errors when reading from a server fails.
+type networkReader struct {
+	io.Reader
+	url string
+}
+
+func (r networkReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if err != nil && err != io.EOF {
+		err = Errorf(ErrNetwork, "Error while downloading %s - %v", r.url, err)
+	}
+	return n, err
+}
+
+// partSuffix is added to files while they are downloaded.
+const partSuffix = ".part"
+
+// validatorSuffix is added to part files to keep the ETag or Last-Modified of their download.
+const validatorSuffix = ".validator"
+
+// responseValidator give the strong ETag of res, or its Last-Modified, for If-Range.
+func responseValidator(res *http.Response) string {
+	if etag := res.Header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		return etag
+	}
+	return res.Header.Get("Last-Modified")
+}
+
+// readValidator give the validator kept with part, "" if none.
+func readValidator(part string) string {
+	content, err := ioutil.ReadFile(part + validatorSuffix)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
+
+// removePart remove part and its validator.
+func removePart(part string) error {
+	os.Remove(part + validatorSuffix)
+	if err := os.Remove(part); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// completeLength give the length of the file in a 416 response, like "bytes */1234".
+// Return -1 if unknown.
+func completeLength(res *http.Response) int64 {
+	contentRange := res.Header.Get("Content-Range")
+	if !strings.HasPrefix(contentRange, "bytes */") {
+		return -1
+	}
+	length, err := strconv.ParseInt(contentRange[len("bytes */"):], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return length
+}
+
+// rangeStart give the first byte of a 206 response, like "bytes 1000-1999/2000".
+// Return -1 if unknown.
+func rangeStart(res *http.Response) int64 {
+	contentRange := res.Header.Get("Content-Range")
+	if !strings.HasPrefix(contentRange, "bytes ") {
+		return -1
+	}
+	end := strings.Index(contentRange, "-")
+	if end < 0 {
+		return -1
+	}
+	start, err := strconv.ParseInt(contentRange[len("bytes "):end], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return start
+}
+
+// DownloadFile download myURL into fileName.
+// Data is written in fileName.part, renamed to fileName when complete.
+// If download fails or ctx is cancelled, the part file is removed unless opts.Resume is set.
+// With opts.Resume, an existing part file is continued if the server accept ranges
+// and the file didn't change since, using If-Range with the ETag or Last-Modified
+// kept in fileName.part.validator. Part files without validator are downloaded again.
+// With opts.Segments > 1, the file is downloaded with several ranges in parallel if the
+// server accept ranges. Segmented part files are always removed on failure.
+func (cl *Client) DownloadFile(ctx context.Context, myURL string, fileName string, opts DownloadOptions) error {
+	cl.debugf("Downloading %s to %s", myURL, fileName)
+	if opts.DryRun {
+		return nil
+	}
+	part := fileName + partSuffix
+	var offset int64
+	validator := readValidator(part)
+	if info, err := os.Stat(part); err == nil && opts.Resume && validator != "" {
+		offset = info.Size()
+	}
+	if opts.Segments > 1 && offset == 0 {
+		done, err := cl.downloadSegments(ctx, myURL, fileName, opts)
+		if done || err != nil {
+			return err
+		}
+		cl.debugf("%s don't accept ranges, downloading it in one segment", myURL)
+	}
+	req, err := http.NewRequest("GET", myURL, nil)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		req.Header.Set("If-Range", validator) // whole file is sent if it changed
+	}
+	response, err := cl.httpClient().Do(req.WithContext(ctx))
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return Errorf(ErrNetwork, "Error while downloading %s - %v", myURL, err)
+	}
+	defer response.Body.Close()
+	switch response.StatusCode {
+	case http.StatusOK:
+		offset = 0 // whole file is sent
+		os.Remove(part + validatorSuffix)
+		if validator = responseValidator(response); opts.Resume && validator != "" {
+			if err = ioutil.WriteFile(part+validatorSuffix, []byte(validator), 0666); err != nil {
+				return err
+			}
+		}
+	case http.StatusPartialContent:
+		if rangeStart(response) != offset {
+			if offset == 0 {
+				return Errorf(ErrHTTPStatus, "Error while downloading %v, server return an unexpected range %q", myURL, response.Header.Get("Content-Range"))
+			}
+			// range is not the one asked, start again
+			if err = removePart(part); err != nil {
+				return err
+			}
+			response.Body.Close()
+			return cl.DownloadFile(ctx, myURL, fileName, opts)
+		}
+		cl.debugf("Resuming %s after %d bytes", fileName, offset)
+	case http.StatusRequestedRangeNotSatisfiable:
+		if offset == 0 {
+			return Errorf(ErrHTTPStatus, "Error while downloading %v, server return code %d", myURL, response.StatusCode)
+		}
+		if completeLength(response) == offset {
+			// part file is complete, it was not renamed
+			os.Remove(part + validatorSuffix)
+			if err = os.Rename(part, fileName); err != nil {
+				return err
+			}
+			cl.logf("%s downloaded.", fileName)
+			return nil
+		}
+		// part file is not a part of this file, start again
+		if err = removePart(part); err != nil {
+			return err
+		}
+		return cl.DownloadFile(ctx, myURL, fileName, opts)
+	case http.StatusNotFound:
+		return NotFoundError{myURL}
+	default:
+		return Errorf(ErrHTTPStatus, "Error while downloading %v, server return code %d", myURL, response.StatusCode)
+	}
+
+	// If no error, create file
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if offset > 0 {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+	f, err := os.OpenFile(part, flags, 0666)
+	if err != nil {
+		return fmt.Errorf("Error while creating %s - %v", part, err)
+	}
+	var output io.Writer = f
+	var progress Progress
+	if opts.Progress != nil {
+		progress = opts.Progress(response.ContentLength)
+	}
+	if progress != nil {
+		output = io.MultiWriter(output, progress)
+	}
+	var body io.Reader = networkReader{response.Body, myURL}
+	if cl.Limiter != nil {
+		body = limitedReader{body, ctx, cl.Limiter}
+	}
+	n, err := io.Copy(output, body)
+	if progress != nil {
+		progress.Finish()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if opts.Resume {
+			cl.logf("Partial download kept in %s", part)
+		} else {
+			removePart(part)
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if _, ok := err.(*Error); ok {
+			return err
+		}
+		return fmt.Errorf("Error while writing %s - %v", fileName, err)
+	}
+	if err = os.Rename(part, fileName); err != nil {
+		return err
+	}
+	os.Remove(part + validatorSuffix)
+	cl.logf("%s downloaded.", fileName)
+	cl.debugf("%d bytes downloaded.", n)
+	return nil
+}
@@ -0,0 +1,461 @@
+package geofabrik
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const monacoPbf = "monaco osm.pbf content"
+
+// newTestServer serve europe/monaco files and make a Client for it.
+// hits count requests by path.
+func newTestServer(hits map[string]int) (*httptest.Server, *Client) {
+	var mu sync.Mutex // requests may be made in parallel
+	sum := md5.Sum([]byte(monacoPbf))
+	files := map[string]string{
+		"/europe/monaco.osm.pbf":      monacoPbf,
+		"/europe/monaco.osm.pbf.md5":  hex.EncodeToString(sum[:]) + "  monaco.osm.pbf\n",
+		"/europe/monaco.poly":         "monaco\n1\nEND\nEND\n",
+		"/europe/corrupt.osm.pbf":     "corrupted",
+		"/europe/corrupt.osm.pbf.md5": hex.EncodeToString(sum[:]) + "  corrupt.osm.pbf\n",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		hits[r.URL.Path]++
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/europe/error.osm.pbf":
+			http.Error(w, "oops", http.StatusInternalServerError)
+			return
+		case "/europe/norange.osm.pbf":
+			w.Write([]byte(monacoPbf))
+			return
+		case "/europe/badrange.osm.pbf":
+			// answer ranges with the whole file
+			w.Header().Set("ETag", `"badrange"`)
+			if r.Header.Get("Range") != "" {
+				w.Header().Set("Content-Range", fmt.Sprintf("bytes 0-%d/%d", len(monacoPbf)-1, len(monacoPbf)))
+				w.WriteHeader(http.StatusPartialContent)
+			}
+			w.Write([]byte(monacoPbf))
+			return
+		case "/europe/slow.osm.pbf":
+			// send a part of the file, then wait until client give up
+			w.Header().Set("Content-Length", strconv.Itoa(len(monacoPbf)))
+			w.Header().Set("ETag", `"slow"`)
+			w.Write([]byte(monacoPbf[:6]))
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		content, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		sum := md5.Sum([]byte(content))
+		w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+		http.ServeContent(w, r, r.URL.Path, time.Time{}, strings.NewReader(content))
+	}))
+	client := NewClient()
+	client.Config = &Config{
+		BaseURL: server.URL,
+		Formats: map[string]Format{
+			"osm.pbf":     {ID: "osm.pbf", Loc: ".osm.pbf"},
+			"osm.pbf.md5": {ID: "osm.pbf.md5", Loc: ".osm.pbf.md5"},
+			"poly":        {ID: "poly", Loc: ".poly"},
+		},
+		Elements: map[string]Element{
+			"europe":  {ID: "europe", Meta: true},
+			"monaco":  {ID: "monaco", Parent: "europe", Formats: []string{"osm.pbf", "osm.pbf.md5", "poly"}},
+			"missing": {ID: "missing", Parent: "europe", Formats: []string{"osm.pbf", "osm.pbf.md5"}},
+			"error":   {ID: "error", Parent: "europe", Formats: []string{"osm.pbf"}},
+			"slow":    {ID: "slow", Parent: "europe", Formats: []string{"osm.pbf"}},
+			"corrupt": {ID: "corrupt", Parent: "europe", Formats: []string{"osm.pbf", "osm.pbf.md5"}},
+		},
+	}
+	return server, client
+}
+
+func TestLocalFile(t *testing.T) {
+	if got := LocalFile("", "monaco", "osm.pbf"); got != "monaco.osm.pbf" {
+		t.Errorf("LocalFile() = %v, want monaco.osm.pbf", got)
+	}
+	if got := LocalFile("/tmp/osm", "monaco", "poly"); got != filepath.Join("/tmp/osm", "monaco.poly") {
+		t.Errorf("LocalFile() = %v, want /tmp/osm/monaco.poly", got)
+	}
+}
+
+func TestClient_DownloadFile(t *testing.T) {
+	server, client := newTestServer(map[string]int{})
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tests := []struct {
+		name     string
+		path     string
+		opts     DownloadOptions
+		want     string
+		wantErr  error // kind of error
+		notFound bool
+	}{
+		{name: "ok", path: "/europe/monaco.osm.pbf", want: monacoPbf},
+		{name: "dry run", path: "/europe/monaco.osm.pbf", opts: DownloadOptions{DryRun: true}},
+		{name: "404", path: "/europe/missing.osm.pbf", wantErr: ErrHTTPStatus, notFound: true},
+		{name: "500", path: "/europe/error.osm.pbf", wantErr: ErrHTTPStatus},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename := filepath.Join(dir, tt.name)
+			err := client.DownloadFile(context.Background(), server.URL+tt.path, filename, tt.opts)
+			if Cause(err) != tt.wantErr {
+				t.Fatalf("Client.DownloadFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if _, ok := err.(NotFoundError); ok != tt.notFound {
+				t.Errorf("Client.DownloadFile() error = %#v, want a NotFoundError: %v", err, tt.notFound)
+			}
+			content, _ := ioutil.ReadFile(filename)
+			if string(content) != tt.want {
+				t.Errorf("Client.DownloadFile() write %q, want %q", content, tt.want)
+			}
+		})
+	}
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	if err := client.DownloadFile(context.Background(), closed.URL+"/europe/monaco.osm.pbf", filepath.Join(dir, "closed"), DownloadOptions{}); Cause(err) != ErrNetwork {
+		t.Errorf("Client.DownloadFile() error = %v, wantErr %v", err, ErrNetwork)
+	}
+}
+
+// fakeProgress count written bytes.
+type fakeProgress struct {
+	bytes.Buffer
+	finished bool
+}
+
+func (p *fakeProgress) Finish() {
+	p.finished = true
+}
+
+func TestClient_DownloadFile_progress(t *testing.T) {
+	server, client := newTestServer(map[string]int{})
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	progress := new(fakeProgress)
+	opts := DownloadOptions{Progress: func(size int64) Progress {
+		if size != int64(len(monacoPbf)) {
+			t.Errorf("Progress() size = %d, want %d", size, len(monacoPbf))
+		}
+		return progress
+	}}
+	if err := client.DownloadFile(context.Background(), server.URL+"/europe/monaco.osm.pbf", filepath.Join(dir, "monaco.osm.pbf"), opts); err != nil {
+		t.Fatal(err)
+	}
+	if progress.String() != monacoPbf || !progress.finished {
+		t.Errorf("Progress got %q, finished = %v", progress.String(), progress.finished)
+	}
+}
+
+func TestClient_DownloadFile_resume(t *testing.T) {
+	hits := make(map[string]int)
+	server, client := newTestServer(hits)
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sum := md5.Sum([]byte(monacoPbf))
+	etag := `"` + hex.EncodeToString(sum[:]) + `"`
+	tests := []struct {
+		name      string
+		part      string
+		validator string
+		wantHits  int
+	}{
+		{name: "continue part", part: monacoPbf[:6], validator: etag, wantHits: 1},
+		{name: "changed upstream", part: "MONACO", validator: `"old"`, wantHits: 1},
+		{name: "no validator", part: "MONACO", wantHits: 1},
+		{name: "part complete", part: monacoPbf, validator: etag, wantHits: 1},
+		{name: "part too big", part: monacoPbf + "garbage", validator: etag, wantHits: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename := filepath.Join(dir, "monaco.osm.pbf")
+			ioutil.WriteFile(filename+partSuffix, []byte(tt.part), 0644)
+			os.Remove(filename + partSuffix + validatorSuffix)
+			if tt.validator != "" {
+				ioutil.WriteFile(filename+partSuffix+validatorSuffix, []byte(tt.validator), 0644)
+			}
+			hits["/europe/monaco.osm.pbf"] = 0
+			err := client.DownloadFile(context.Background(), server.URL+"/europe/monaco.osm.pbf", filename, DownloadOptions{Resume: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if content, _ := ioutil.ReadFile(filename); string(content) != monacoPbf {
+				t.Errorf("Client.DownloadFile() write %q, want %q", content, monacoPbf)
+			}
+			if hits["/europe/monaco.osm.pbf"] != tt.wantHits {
+				t.Errorf("Client.DownloadFile() made %d requests, want %d", hits["/europe/monaco.osm.pbf"], tt.wantHits)
+			}
+			if fileExist(filename+partSuffix) || fileExist(filename+partSuffix+validatorSuffix) {
+				t.Errorf("Client.DownloadFile() must remove %s and its validator", filename+partSuffix)
+			}
+		})
+	}
+}
+
+func Test_rangeStart(t *testing.T) {
+	tests := []struct {
+		name         string
+		contentRange string
+		want         int64
+	}{
+		{name: "range", contentRange: "bytes 6-22/23", want: 6},
+		{name: "unknown length", contentRange: "bytes 6-22/*", want: 6},
+		{name: "unsatisfied", contentRange: "bytes */23", want: -1},
+		{name: "none", contentRange: "", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{Header: http.Header{"Content-Range": {tt.contentRange}}}
+			if got := rangeStart(res); got != tt.want {
+				t.Errorf("rangeStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DownloadFile_badRange(t *testing.T) {
+	hits := make(map[string]int)
+	server, client := newTestServer(hits)
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "badrange.osm.pbf")
+	ioutil.WriteFile(filename+partSuffix, []byte(monacoPbf[:6]), 0644)
+	ioutil.WriteFile(filename+partSuffix+validatorSuffix, []byte(`"badrange"`), 0644)
+	if err = client.DownloadFile(context.Background(), server.URL+"/europe/badrange.osm.pbf", filename, DownloadOptions{Resume: true}); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := ioutil.ReadFile(filename); string(content) != monacoPbf {
+		t.Errorf("Client.DownloadFile() write %q, want %q", content, monacoPbf)
+	}
+	if hits["/europe/badrange.osm.pbf"] != 2 {
+		t.Errorf("Client.DownloadFile() made %d requests, want 2", hits["/europe/badrange.osm.pbf"])
+	}
+}
+
+func Test_responseValidator(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		want   string
+	}{
+		{name: "etag", header: http.Header{"Etag": {`"5c9a"`}, "Last-Modified": {"Tue, 02 Apr 2019 20:15:02 GMT"}}, want: `"5c9a"`},
+		{name: "weak etag", header: http.Header{"Etag": {`W/"5c9a"`}, "Last-Modified": {"Tue, 02 Apr 2019 20:15:02 GMT"}}, want: "Tue, 02 Apr 2019 20:15:02 GMT"},
+		{name: "none", header: http.Header{}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := responseValidator(&http.Response{Header: tt.header}); got != tt.want {
+				t.Errorf("responseValidator() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DownloadFile_validator(t *testing.T) {
+	server, client := newTestServer(map[string]int{})
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	filename := filepath.Join(dir, "slow.osm.pbf")
+	opts := DownloadOptions{Resume: true, Progress: func(int64) Progress { return cancelProgress{cancel} }}
+	client.DownloadFile(ctx, server.URL+"/europe/slow.osm.pbf", filename, opts)
+	if got := readValidator(filename + partSuffix); got != `"slow"` {
+		t.Errorf("Client.DownloadFile() keep validator %q with %s, want %q", got, filename+partSuffix, `"slow"`)
+	}
+}
+
+// cancelProgress cancel a download when it receive data.
+type cancelProgress struct {
+	cancel func()
+}
+
+func (p cancelProgress) Write(b []byte) (int, error) {
+	p.cancel()
+	return len(b), nil
+}
+
+func (p cancelProgress) Finish() {}
+
+func TestClient_DownloadFile_cancel(t *testing.T) {
+	server, client := newTestServer(map[string]int{})
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, resume := range []bool{false, true} {
+		t.Run("resume "+strconv.FormatBool(resume), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			filename := filepath.Join(dir, "slow.osm.pbf")
+			os.Remove(filename + partSuffix)
+			opts := DownloadOptions{Resume: resume, Progress: func(int64) Progress { return cancelProgress{cancel} }}
+			err := client.DownloadFile(ctx, server.URL+"/europe/slow.osm.pbf", filename, opts)
+			if err != context.Canceled {
+				t.Errorf("Client.DownloadFile() error = %v, want %v", err, context.Canceled)
+			}
+			if fileExist(filename) {
+				t.Errorf("Client.DownloadFile() must not write %s", filename)
+			}
+			if fileExist(filename+partSuffix) != resume {
+				t.Errorf("Client.DownloadFile() keep part file = %v, want %v", !resume, resume)
+			}
+		})
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := client.Download(ctx, "monaco", []string{"osm.pbf"}, DownloadOptions{OutputDir: dir}); err != context.Canceled {
+		t.Errorf("Client.Download() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestClient_Download(t *testing.T) {
+	tests := []struct {
+		name     string
+		element  string
+		formats  []string
+		existing string // content of monaco.osm.pbf before download
+		check    bool
+		wantHits map[string]int
+		wantErr  error // kind of error
+	}{
+		{
+			name:     "no check",
+			element:  "monaco",
+			formats:  []string{"osm.pbf", "poly"},
+			wantHits: map[string]int{"/europe/monaco.osm.pbf": 1, "/europe/monaco.poly": 1},
+		},
+		{
+			name:     "check",
+			element:  "monaco",
+			formats:  []string{"osm.pbf"},
+			check:    true,
+			wantHits: map[string]int{"/europe/monaco.osm.pbf": 1, "/europe/monaco.osm.pbf.md5": 1},
+		},
+		{
+			name:     "check file exist",
+			element:  "monaco",
+			formats:  []string{"osm.pbf"},
+			existing: monacoPbf,
+			check:    true,
+			wantHits: map[string]int{"/europe/monaco.osm.pbf.md5": 1},
+		},
+		{
+			name:     "check file exist checksum mismatch",
+			element:  "monaco",
+			formats:  []string{"osm.pbf"},
+			existing: "corrupted",
+			check:    true,
+			wantHits: map[string]int{"/europe/monaco.osm.pbf": 1, "/europe/monaco.osm.pbf.md5": 2},
+		},
+		{
+			name:     "not found",
+			element:  "missing",
+			formats:  []string{"osm.pbf"},
+			check:    true,
+			wantHits: map[string]int{"/europe/missing.osm.pbf": 1},
+			wantErr:  ErrHTTPStatus,
+		},
+		{
+			name:     "checksum mismatch",
+			element:  "corrupt",
+			formats:  []string{"osm.pbf"},
+			check:    true,
+			wantHits: map[string]int{"/europe/corrupt.osm.pbf": 1, "/europe/corrupt.osm.pbf.md5": 1},
+			wantErr:  ErrChecksumMismatch,
+		},
+		{
+			name:    "unknown element",
+			element: "france",
+			formats: []string{"osm.pbf"},
+			wantErr: ErrElementNotFound,
+		},
+		{
+			name:    "unknown format",
+			element: "monaco",
+			formats: []string{"shp.zip"},
+			wantErr: ErrFormatUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits := make(map[string]int)
+			server, client := newTestServer(hits)
+			defer server.Close()
+			dir, err := ioutil.TempDir("", "download-geofabrik")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			if tt.existing != "" {
+				ioutil.WriteFile(LocalFile(dir, tt.element, "osm.pbf"), []byte(tt.existing), 0644)
+			}
+			err = client.Download(context.Background(), tt.element, tt.formats, DownloadOptions{OutputDir: dir, Check: tt.check})
+			if Cause(err) != tt.wantErr {
+				t.Fatalf("Client.Download() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(hits) != len(tt.wantHits) {
+				t.Errorf("Client.Download() requests = %v, want %v", hits, tt.wantHits)
+			}
+			for path, n := range tt.wantHits {
+				if hits[path] != n {
+					t.Errorf("Client.Download() requests = %v, want %v", hits, tt.wantHits)
+				}
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			for _, f := range tt.formats {
+				if !fileExist(LocalFile(dir, tt.element, f)) {
+					t.Errorf("Client.Download() don't write %s.%s", tt.element, f)
+				}
+			}
+			if content, _ := ioutil.ReadFile(LocalFile(dir, tt.element, "osm.pbf")); string(content) != monacoPbf {
+				t.Errorf("Client.Download() osm.pbf = %q, want %q", content, monacoPbf)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package geofabrik
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Element is a region, country or area available in a Config.
+type Element struct {
+	ID      string              `yaml:"id" json:"id" toml:"id"`
+	File    string              `yaml:"file,omitempty" json:"file,omitempty" toml:"file,omitempty"`
+	Meta    bool                `yaml:"meta,omitempty" json:"meta,omitempty" toml:"meta,omitempty"`
+	Name    string              `yaml:"name,omitempty" json:"name,omitempty" toml:"name,omitempty"`
+	Formats []string            `yaml:"files,omitempty" json:"files,omitempty" toml:"files,omitempty"`
+	Parent  string              `yaml:"parent,omitempty" json:"parent,omitempty" toml:"parent,omitempty"`
+	Info    map[string]FileInfo `yaml:"info,omitempty" json:"info,omitempty" toml:"info,omitempty"` // by format
+}
+
+// FileInfo is what the service tell about a file.
+type FileInfo struct {
+	Size int64     `yaml:"size,omitempty" json:"size,omitempty" toml:"size,omitzero"` // in bytes, may be rounded
+	Date time.Time `yaml:"date,omitempty" json:"date" toml:"date"`                    // last modification upstream
+}
+
+// HasParent is true if e is in another element.
+func (e *Element) HasParent() bool {
+	return len(e.Parent) != 0
+}
+
+// AddInfo set FileInfo of a format, empty infos are ignored.
+func (e *Element) AddInfo(format string, info FileInfo) {
+	if info.Size == 0 && info.Date.IsZero() {
+		return
+	}
+	if e.Info == nil {
+		e.Info = make(map[string]FileInfo)
+	}
+	e.Info[format] = info
+}
+
+// CanMerge return an error if element can't be merged into existing.
+func CanMerge(existing *Element, element *Element) error {
+	if existing.Parent != element.Parent {
+		return fmt.Errorf("Cant merge : Parent mismatch")
+	}
+	return nil
+}
+
+// FindElement give the element id of c.
+func (c *Config) FindElement(id string) (*Element, error) {
+	res := c.Elements[id]
+	if res.ID == "" || res.ID != id {
+		return nil, Errorf(ErrElementNotFound, "%s is not in config\n Please use \"list\" command", id)
+	}
+	return &res, nil
+}
+
+// URL give the URL of e in format.
+func (c *Config) URL(e *Element, format string) (string, error) {
+	var res string
+	var err error
+	if !stringInSlice(&format, &e.Formats) {
+		return "", Errorf(ErrFormatUnavailable, "Error!!! %s format not exist", format)
+	}
+	f := c.Formats[format]
+	if f.BasePath != "" {
+		if f.BaseURL != "" {
+			res, err = c.ElementURL(e, f.BaseURL, f.BasePath)
+		} else {
+			res, err = c.ElementURL(e, f.BasePath)
+		}
+	} else {
+		if f.BaseURL != "" {
+			res, err = c.ElementURL(e, f.BaseURL, "")
+		} else {
+			res, err = c.ElementURL(e)
+		}
+	}
+	// TODO check if valid URL
+	if err != nil {
+		return "", err
+	}
+	res += f.Loc
+	return res, nil
+}
+
+// ElementURL give the URL of e without format location.
+// b is a base path, or a base URL and a base path.
+func (c *Config) ElementURL(e *Element, b ...string) (string, error) {
+	var res string
+	myElem, err := c.FindElement(e.ID)
+	if err != nil {
+		return "", err
+	}
+	if myElem.HasParent() {
+		parent, err := c.FindElement(myElem.Parent)
+		if err != nil {
+			return "", err
+		}
+		res, err = c.ElementURL(parent, b...)
+		if err != nil {
+			return "", err
+		}
+		res = res + "/"
+		if myElem.File != "" { //TODO use file in config???
+			res = res + myElem.File
+		} else {
+			res = res + myElem.ID
+		}
+		return res, nil
+	}
+	switch len(b) {
+	case 1:
+		return c.BaseURL + "/" + strings.Join(b, "/") + myElem.ID, nil
+	case 2:
+		return strings.Join(b, "/") + myElem.ID, nil
+	default:
+		return c.BaseURL + "/" + myElem.ID, nil
+	}
+}
+
+// stringInSlice : Check if a sting is present in a slice
+func stringInSlice(a *string, list *[]string) bool {
+	for _, b := range *list {
+		if b == *a {
+			return true
+		}
+	}
+	return false
+}
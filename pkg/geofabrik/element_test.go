@@ -0,0 +1,493 @@
+package geofabrik
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+var SampleConfigValidPtr = Config{
+	BaseURL:  "https://my.base.url",
+	Formats:  sampleFormatValidPtr,
+	Elements: sampleElementValidPtr,
+}
+
+var sampleFormatValidPtr = map[string]Format{
+	//Blank
+	"": {
+		ID:       "",
+		Loc:      "",
+		BasePath: "",
+	}, "osm.pbf": {
+		ID:  "osm.pbf",
+		Loc: ".osm.pbf",
+		//BasePath: "/",
+	}, "state": {
+		ID:       "state",
+		Loc:      "-updates/state.txt",
+		BasePath: "../state/",
+	}, "poly": {
+		ID:      "poly",
+		Loc:     ".poly",
+		BaseURL: "http://my.new.url/folder",
+	}, "osm.bz2": {
+		ID:       "osm.bz2",
+		Loc:      ".osm.bz2",
+		BasePath: "../osmbz2/",
+		BaseURL:  "http://my.new.url/folder",
+	},
+}
+
+var sampleAfricaElementPtr = Element{
+	ID:   "africa",
+	Name: "Africa",
+	Formats: []string{
+		"osm.pbf",
+		"osm.pbf.md5",
+		"osm.bz2",
+		"osm.bz2.md5",
+		"osh.pbf",
+		"osh.pbf.md5",
+		"poly",
+		"kml",
+		"state",
+	},
+}
+var sampleGeorgiaUsElementPtr = Element{
+	ID:   "georgia-us",
+	File: "georgia",
+	Name: "Georgia (US State)",
+	Formats: []string{
+		"osm.pbf",
+		"osm.pbf.md5",
+		"shp.zip",
+		"osm.bz2",
+		"osm.bz2.md5",
+		"osh.pbf",
+		"osh.pbf.md5",
+		"poly",
+		"kml",
+		"state",
+	},
+	Parent: "us",
+}
+var sampleUsElementPtr = Element{
+	ID:     "us",
+	Meta:   true,
+	Name:   "United States of America",
+	Parent: "north-america",
+}
+var sampleNorthAmericaElementPtr = Element{
+	ID:   "north-america",
+	Name: "North America",
+	Formats: []string{
+		"osm.pbf",
+		"osm.pbf.md5",
+		"osm.bz2",
+		"osm.bz2.md5",
+		"osh.pbf",
+		"osh.pbf.md5",
+		"poly",
+		"kml",
+		"state",
+	},
+}
+var sampleElementValidPtr = map[string]Element{
+	"africa":        sampleAfricaElementPtr,
+	"georgia-us":    sampleGeorgiaUsElementPtr,
+	"us":            sampleUsElementPtr,
+	"north-america": sampleNorthAmericaElementPtr,
+}
+
+//Creating some fake samples
+var sampleFakeGeorgiaPtr = Element{
+	ID:   "georgia-usf",
+	File: "georgia-fake",
+	Name: "Georgia (US State) - fake test",
+	Formats: []string{
+		"osm.pbf",
+		"osm.pbf.md5",
+		"shp.zip",
+		"osm.bz2",
+		"osm.bz2.md5",
+		"osh.pbf",
+		"osh.pbf.md5",
+		"poly",
+		"kml",
+		"state",
+	},
+	Parent: "us", // keep good parent!
+}
+var sampleFakeGeorgia2Ptr = Element{
+	ID:   "georgia-us2",
+	File: "georgia",
+	Name: "Georgia (US State)",
+	Formats: []string{
+		"osm.pbf",
+		"osm.pbf.md5",
+		"shp.zip",
+		"osm.bz2",
+		"osm.bz2.md5",
+		"osh.pbf",
+		"osh.pbf.md5",
+		"poly",
+		"kml",
+		"state",
+	},
+	Parent: "notus", // bad parent not exist!
+}
+
+func Benchmark_HasParent_parse_geofabrik_yml(b *testing.B) {
+	c, _ := loadConfig("../../geofabrik.yml")
+	for n := 0; n < b.N; n++ {
+		for _, v := range c.Elements {
+			v.HasParent()
+		}
+	}
+}
+
+func TestElement_HasParent(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		fields Element
+		want   bool
+	}{
+		// TODO: Add test cases.
+		{
+			name:   "us Have parent",
+			fields: sampleElementValidPtr["us"],
+			want:   true,
+		}, {
+			name:   "Africa Haven't parent",
+			fields: sampleElementValidPtr["Africa"],
+			want:   false,
+		},
+		{
+			name:   "Haven't parent 2",
+			fields: Element{ID: "", File: "", Meta: true, Name: "", Formats: *new([]string), Parent: ""},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Element{
+				ID:      tt.fields.ID,
+				File:    tt.fields.File,
+				Meta:    tt.fields.Meta,
+				Name:    tt.fields.Name,
+				Formats: tt.fields.Formats,
+				Parent:  tt.fields.Parent,
+			}
+			if got := e.HasParent(); got != tt.want {
+				t.Errorf("Element.HasParent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Benchmark_FindElement_parse_all_geofabrik_yml(b *testing.B) {
+	c, _ := loadConfig("../../geofabrik.yml")
+	for n := 0; n < b.N; n++ {
+		for k := range c.Elements {
+			c.FindElement(k)
+		}
+	}
+}
+
+func Benchmark_FindElement_parse_France_geofabrik_yml(b *testing.B) {
+	c, _ := loadConfig("../../geofabrik.yml")
+	for n := 0; n < b.N; n++ {
+		c.FindElement("france")
+	}
+}
+
+func TestConfig_FindElement(t *testing.T) {
+	type args struct {
+		c *Config
+		e string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *Element
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{
+			name: "Find",
+			args: args{
+				c: &SampleConfigValidPtr,
+				e: "africa",
+			},
+			want: &Element{
+				ID:   "africa",
+				Name: "Africa",
+				Formats: []string{
+					"osm.pbf",
+					"osm.pbf.md5",
+					"osm.bz2",
+					"osm.bz2.md5",
+					"osh.pbf",
+					"osh.pbf.md5",
+					"poly",
+					"kml",
+					"state",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Cant find notInList",
+			args: args{
+				c: &SampleConfigValidPtr,
+				e: "notInList",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Should not find",
+			args: args{
+				c: &SampleConfigValidPtr,
+				e: "",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.args.c.FindElement(tt.args.e)
+			if err != nil != tt.wantErr {
+				t.Errorf("Config.FindElement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Config.FindElement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_URL(t *testing.T) {
+	localSampleConfigValidPtr := SampleConfigValidPtr
+	localSampleConfigValidPtr.Elements["georgia-us2"] = sampleFakeGeorgiaPtr // add it into config
+	type args struct {
+		c   *Config
+		e   *Element
+		ext string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{
+			name:    "top level test config",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleAfricaElementPtr, ext: "osm.pbf"},
+			want:    "https://my.base.url/africa.osm.pbf",
+			wantErr: false,
+		}, {
+			name:    "sub level test config",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleGeorgiaUsElementPtr, ext: "state"},
+			want:    "https://my.base.url/../state/north-america/us/georgia-updates/state.txt",
+			wantErr: false,
+		}, {
+			name:    "BaseUrl test config",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleGeorgiaUsElementPtr, ext: "poly"},
+			want:    "http://my.new.url/folder/north-america/us/georgia.poly",
+			wantErr: false,
+		}, {
+			name:    "BaseUrl + BasePath test config",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleGeorgiaUsElementPtr, ext: "osm.bz2"},
+			want:    "http://my.new.url/folder/../osmbz2/north-america/us/georgia.osm.bz2",
+			wantErr: false,
+		},
+		{
+			name:    "sub level test config wrong format",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleGeorgiaUsElementPtr, ext: "wrongFmt"},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "sub level test config not exists in config",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleFakeGeorgiaPtr, ext: "state"},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "sub level test config not exists parent",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleFakeGeorgia2Ptr, ext: "state"},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.args.c.URL(tt.args.e, tt.args.ext)
+			if err != nil != tt.wantErr {
+				t.Errorf("Config.URL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Config.URL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_ElementURL(t *testing.T) {
+	localSampleConfigValidPtr := SampleConfigValidPtr
+	localSampleConfigValidPtr.Elements["georgia-us2"] = sampleFakeGeorgia2Ptr // add it into config
+	type args struct {
+		c *Config
+		e *Element
+		b []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{
+			name:    "top level test config",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleAfricaElementPtr},
+			want:    "https://my.base.url/africa",
+			wantErr: false,
+		}, {
+			name:    "top level test config with basePath base/",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleAfricaElementPtr, b: []string{"base/"}},
+			want:    "https://my.base.url/base/africa",
+			wantErr: false,
+		}, {
+			name:    "sub level test config",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleGeorgiaUsElementPtr},
+			want:    "https://my.base.url/north-america/us/georgia",
+			wantErr: false,
+		}, {
+			name:    "sub level test config not exists in config",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleFakeGeorgiaPtr},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "sub level test config not exists parent",
+			args:    args{c: &localSampleConfigValidPtr, e: &sampleFakeGeorgia2Ptr},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.args.c.ElementURL(tt.args.e, tt.args.b...)
+			if err != nil != tt.wantErr {
+				t.Errorf("Config.ElementURL() =%v error = %v, wantErr %v", got, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Config.ElementURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Benchmark_ElementURL_parse_France_geofabrik_yml(b *testing.B) {
+	c, err := loadConfig("../../geofabrik.yml")
+	if err != nil {
+		b.Fatal(err)
+	}
+	france, err := c.FindElement("france")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for n := 0; n < b.N; n++ {
+		c.ElementURL(france)
+	}
+}
+
+func Benchmark_URL_parse_France_geofabrik_yml(b *testing.B) {
+	c, err := loadConfig("../../geofabrik.yml")
+	if err != nil {
+		b.Fatal(err)
+	}
+	france, err := c.FindElement("france")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for n := 0; n < b.N; n++ {
+		c.URL(france, "state")
+	}
+}
+
+func Benchmark_URL_parse_France_openstreetmap_fr_yml(b *testing.B) {
+	c, err := loadConfig("../../openstreetmap.fr.yml")
+	if err != nil {
+		b.Fatal(err)
+	}
+	france, err := c.FindElement("france")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for n := 0; n < b.N; n++ {
+		c.URL(france, "poly")
+	}
+}
+
+func TestElement_AddInfo(t *testing.T) {
+	date := time.Date(2019, 4, 2, 20, 15, 2, 0, time.UTC)
+	tests := []struct {
+		name   string
+		e      Element
+		format string
+		info   FileInfo
+		want   map[string]FileInfo
+	}{
+		{name: "empty info is ignored", e: Element{}, format: "osm.pbf", info: FileInfo{}, want: nil},
+		{name: "first info", e: Element{}, format: "osm.pbf", info: FileInfo{Size: 42, Date: date}, want: map[string]FileInfo{"osm.pbf": {Size: 42, Date: date}}},
+		{name: "only date", e: Element{}, format: "state", info: FileInfo{Date: date}, want: map[string]FileInfo{"state": {Date: date}}},
+		{
+			name:   "second info",
+			e:      Element{Info: map[string]FileInfo{"osm.pbf": {Size: 42}}},
+			format: "poly",
+			info:   FileInfo{Size: 1},
+			want:   map[string]FileInfo{"osm.pbf": {Size: 42}, "poly": {Size: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.e.AddInfo(tt.format, tt.info)
+			if !reflect.DeepEqual(tt.e.Info, tt.want) {
+				t.Errorf("Element.AddInfo() Info = %v, want %v", tt.e.Info, tt.want)
+			}
+		})
+	}
+}
+
+func TestElement_yaml_Info(t *testing.T) {
+	e := Element{
+		ID:      "monaco",
+		Formats: []string{"osm.pbf", "state"},
+		Info: map[string]FileInfo{
+			"osm.pbf": {Size: 64382566, Date: time.Date(2019, 4, 2, 20, 15, 2, 0, time.UTC)},
+			"state":   {Size: 107},
+		},
+	}
+	out, err := yaml.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Element
+	if err := yaml.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, e) {
+		t.Errorf("yaml round trip = %+v, want %+v\n%s", got, e, out)
+	}
+}
@@ -0,0 +1,47 @@
+package geofabrik
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kinds of errors returned by Client, use Cause to find them.
+var (
+	ErrElementNotFound   = errors.New("element not found")
+	ErrFormatUnavailable = errors.New("format not available")
+	ErrChecksumMismatch  = errors.New("checksum mismatch")
+	ErrHTTPStatus        = errors.New("unexpected HTTP status")
+	ErrNetwork           = errors.New("network error")
+)
+
+// Error is an error of a Kind, one of Err* errors.
+// Msg is the message for users.
+type Error struct {
+	Kind error
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return e.Msg
+}
+
+// Cause give the kind of e.
+func (e *Error) Cause() error {
+	return e.Kind
+}
+
+// Errorf make an Error of kind, formatted like fmt.Errorf.
+func Errorf(kind error, format string, v ...interface{}) error {
+	return &Error{Kind: kind, Msg: fmt.Sprintf(format, v...)}
+}
+
+// Cause give the kind of err, like ErrNetwork.
+// err is returned as is if it has no kind.
+func Cause(err error) error {
+	if e, ok := err.(interface {
+		Cause() error
+	}); ok {
+		return e.Cause()
+	}
+	return err
+}
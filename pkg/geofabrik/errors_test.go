@@ -0,0 +1,41 @@
+package geofabrik
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCause(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "nil", err: nil, want: nil},
+		{name: "no kind", err: fmt.Errorf("oops"), want: nil},
+		{name: "Error", err: Errorf(ErrNetwork, "oops"), want: ErrNetwork},
+		{name: "NotFoundError", err: NotFoundError{URL: "https://my.base.url/monaco.osm.pbf"}, want: ErrHTTPStatus},
+		{name: "sentinel", err: ErrChecksumMismatch, want: ErrChecksumMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.want
+			if want == nil {
+				want = tt.err
+			}
+			if got := Cause(tt.err); got != want {
+				t.Errorf("Cause() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestErrorf(t *testing.T) {
+	err := Errorf(ErrElementNotFound, "%s is not in config", "france")
+	if err.Error() != "france is not in config" {
+		t.Errorf("Errorf().Error() = %q, want %q", err.Error(), "france is not in config")
+	}
+	if Cause(err) != ErrElementNotFound {
+		t.Errorf("Cause(Errorf()) = %v, want %v", Cause(err), ErrElementNotFound)
+	}
+}
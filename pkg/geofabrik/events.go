@@ -0,0 +1,102 @@
+package geofabrik
+
+import (
+	"context"
+	"time"
+)
+
+// Types of Event.
+const (
+	EventDownloadStarted  = "download_started"  // Bytes is the expected size, -1 if unknown
+	EventProgress         = "progress"          // Bytes is downloaded bytes
+	EventDownloadFinished = "download_finished" // Bytes is downloaded bytes
+	EventChecksumOK       = "checksum_ok"       // Bytes is the size of the file
+	EventChecksumMismatch = "checksum_mismatch" // Bytes is the size of the file
+	EventSkippedUpToDate  = "skipped_up_to_date"
+	EventError            = "error" // Err is set
+)
+
+// eventInterval is the minimal time between two progress events of a file.
+const eventInterval = time.Second
+
+// Event tell what a Client is doing, see Client.Events.
+type Event struct {
+	Type     string
+	Element  string
+	Format   string
+	URL      string
+	Bytes    int64
+	Duration time.Duration // since the start of the download or the check
+	Err      error
+}
+
+func (cl *Client) event(e Event) {
+	if cl.Events != nil {
+		cl.Events(e)
+	}
+}
+
+// eventProgress send progress events, at most one by eventInterval.
+// Written bytes are also given to next.
+type eventProgress struct {
+	cl      *Client
+	event   Event
+	start   time.Time
+	last    time.Time
+	written int64
+	next    Progress
+}
+
+func (p *eventProgress) Write(b []byte) (int, error) {
+	p.written += int64(len(b))
+	if now := time.Now(); now.Sub(p.last) >= eventInterval {
+		p.last = now
+		e := p.event
+		e.Type = EventProgress
+		e.Bytes = p.written
+		e.Duration = now.Sub(p.start)
+		p.cl.event(e)
+	}
+	if p.next == nil {
+		return len(b), nil
+	}
+	return p.next.Write(b)
+}
+
+func (p *eventProgress) Finish() {
+	if p.next != nil {
+		p.next.Finish()
+	}
+}
+
+// downloadWithEvents is DownloadFile sending download_started, progress and download_finished events.
+// e give the element, format and URL of events.
+func (cl *Client) downloadWithEvents(ctx context.Context, e Event, fileName string, opts DownloadOptions) error {
+	if cl.Events == nil {
+		return cl.DownloadFile(ctx, e.URL, fileName, opts)
+	}
+	start := time.Now()
+	var progress *eventProgress
+	next := opts.Progress
+	opts.Progress = func(size int64) Progress {
+		started := e
+		started.Type = EventDownloadStarted
+		started.Bytes = size
+		cl.event(started)
+		progress = &eventProgress{cl: cl, event: e, start: start}
+		if next != nil {
+			progress.next = next(size)
+		}
+		return progress
+	}
+	if err := cl.DownloadFile(ctx, e.URL, fileName, opts); err != nil {
+		return err
+	}
+	if progress != nil {
+		e.Type = EventDownloadFinished
+		e.Bytes = progress.written
+		e.Duration = time.Since(start)
+		cl.event(e)
+	}
+	return nil
+}
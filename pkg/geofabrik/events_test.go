@@ -0,0 +1,75 @@
+package geofabrik
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestClient_Download_events(t *testing.T) {
+	tests := []struct {
+		name     string
+		element  string
+		existing string // content of osm.pbf before download
+		check    bool
+		want     []string
+	}{
+		{name: "no check", element: "monaco", want: []string{EventDownloadStarted, EventProgress, EventDownloadFinished}},
+		{name: "check", element: "monaco", check: true, want: []string{EventDownloadStarted, EventProgress, EventDownloadFinished, EventChecksumOK}},
+		{name: "up to date", element: "monaco", existing: monacoPbf, check: true, want: []string{EventChecksumOK, EventSkippedUpToDate}},
+		{name: "mismatch", element: "corrupt", check: true, want: []string{EventDownloadStarted, EventProgress, EventDownloadFinished, EventChecksumMismatch, EventError}},
+		{name: "not found", element: "missing", want: []string{EventError}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := newTestServer(make(map[string]int))
+			defer server.Close()
+			dir, err := ioutil.TempDir("", "download-geofabrik")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			if tt.existing != "" {
+				ioutil.WriteFile(LocalFile(dir, tt.element, "osm.pbf"), []byte(tt.existing), 0644)
+			}
+			var events []Event
+			client.Events = func(e Event) {
+				events = append(events, e)
+			}
+			client.Download(context.Background(), tt.element, []string{"osm.pbf"}, DownloadOptions{OutputDir: dir, Check: tt.check})
+			var got []string
+			for _, e := range events {
+				got = append(got, e.Type)
+				if e.Element != tt.element || e.Format != "osm.pbf" || e.URL == "" {
+					t.Errorf("Client.Events got %+v, want element %s and format osm.pbf with URL", e, tt.element)
+				}
+				if e.Type == EventError && e.Err == nil {
+					t.Errorf("Client.Events got %+v, want an error", e)
+				}
+				if e.Type == EventDownloadFinished && e.Bytes != int64(len(monacoPbf)) && e.Bytes != int64(len("corrupted")) {
+					t.Errorf("Client.Events got %+v, want downloaded bytes", e)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Events got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_eventProgress(t *testing.T) {
+	var events []Event
+	next := new(fakeProgress)
+	p := &eventProgress{cl: &Client{Events: func(e Event) { events = append(events, e) }}, event: Event{Element: "monaco"}, next: next}
+	p.Write([]byte("monaco "))
+	p.Write([]byte("osm.pbf"))
+	p.Finish()
+	if len(events) != 1 || events[0].Type != EventProgress || events[0].Bytes != 7 {
+		t.Errorf("eventProgress send %+v, want only one progress event of 7 bytes", events)
+	}
+	if next.String() != "monaco osm.pbf" || !next.finished {
+		t.Errorf("eventProgress give %q to next, finished = %v", next.String(), next.finished)
+	}
+}
@@ -0,0 +1,164 @@
+// 2015-2018 copyright Julien Noblet
+
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package geofabrik download OSM files from services described by a config file,
+// like geofabrik.yml. It's the library used by download-geofabrik.
+//
+//	client := geofabrik.NewClient()
+//	if _, err := client.LoadConfig("geofabrik.yml"); err != nil {
+//		log.Fatal(err)
+//	}
+//	err := client.Download(context.Background(), "monaco", []string{"osm.pbf"}, geofabrik.DownloadOptions{Check: true})
+package geofabrik
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// Client download elements of its Config.
+// Fields may be changed before using it, but not during a download.
+type Client struct {
+	Config     *Config      // set by LoadConfig
+	HTTPClient *http.Client // default is http.DefaultClient
+	// ReadFile is used to read config files, default is ioutil.ReadFile.
+	// It's useful to find config files elsewhere, like in a cache.
+	ReadFile func(filename string) ([]byte, error)
+	Logger   *log.Logger // nil is quiet
+	Verbose  bool        // log more details with Logger
+	// Events is told about downloads and checksums if not nil, see Event.
+	Events func(Event)
+	// Limiter limit the rate of all downloads of the Client, nil is unlimited.
+	Limiter *Limiter
+}
+
+// NewClient make a Client without config, use LoadConfig or set Config.
+func NewClient() *Client {
+	return &Client{
+		HTTPClient: http.DefaultClient,
+		ReadFile:   ioutil.ReadFile,
+	}
+}
+
+func (cl *Client) logf(format string, v ...interface{}) {
+	if cl.Logger != nil {
+		cl.Logger.Printf(format, v...)
+	}
+}
+
+func (cl *Client) debugf(format string, v ...interface{}) {
+	if cl.Verbose {
+		cl.logf(format, v...)
+	}
+}
+
+func (cl *Client) httpClient() *http.Client {
+	if cl.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return cl.HTTPClient
+}
+
+func (cl *Client) readFile(filename string) ([]byte, error) {
+	if cl.ReadFile == nil {
+		return ioutil.ReadFile(filename)
+	}
+	return cl.ReadFile(filename)
+}
+
+// LoadConfig load configFile into cl.Config.
+// Files in include are loaded first, then configFile is merged on top of them.
+func (cl *Client) LoadConfig(configFile string) (*Config, error) {
+	c, err := cl.loadIncludes(configFile, nil)
+	if err != nil {
+		return nil, err
+	}
+	cl.Config = c
+	return c, nil
+}
+
+// loadIncludes load configFile and its includes.
+// parents are files including configFile, used to find loops.
+func (cl *Client) loadIncludes(configFile string, parents []string) (*Config, error) {
+	abs, _ := filepath.Abs(configFile)
+	if stringInSlice(&abs, &parents) {
+		return nil, fmt.Errorf("%s is included in itself", configFile)
+	}
+	c, err := cl.ReadConfig(configFile)
+	if err != nil || len(c.Include) == 0 {
+		return c, err
+	}
+	parents = append(parents, abs)
+	merged := new(Config)
+	for _, include := range c.Include {
+		included, err := cl.loadIncludes(includePath(configFile, include), parents)
+		if err != nil {
+			return nil, err
+		}
+		if err = MergeConfig(merged, included, include); err != nil {
+			return nil, err
+		}
+	}
+	if err = MergeConfig(merged, c, configFile); err != nil {
+		return nil, err
+	}
+	merged.Version = c.Version
+	merged.Generated = c.Generated
+	return merged, nil
+}
+
+// includePath give the file of include, relative to configFile.
+// If it's not found there, include is given to ReadFile as is,
+// so shipped config files can still be found.
+func includePath(configFile string, include string) string {
+	if filepath.IsAbs(include) {
+		return include
+	}
+	filename := filepath.Join(filepath.Dir(configFile), include)
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
+		return include
+	}
+	return filename
+}
+
+// ReadConfig read a single config file, without includes.
+// Old config files are migrated to ConfigVersion.
+func (cl *Client) ReadConfig(configFile string) (*Config, error) {
+	content, err := cl.readFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+	c := new(Config)
+	if err = UnmarshalConfig(configFile, content, c); err != nil {
+		return nil, err
+	}
+	// Upgrade old config files
+	if err = MigrateConfig(c); err != nil {
+		return nil, fmt.Errorf("%s: %v", configFile, err)
+	}
+	return c, nil
+}
+
+// FindElement give the element id of cl.Config.
+func (cl *Client) FindElement(id string) (*Element, error) {
+	if cl.Config == nil {
+		return nil, fmt.Errorf("no config loaded")
+	}
+	return cl.Config.FindElement(id)
+}
+
+// URL give the URL of element.format.
+func (cl *Client) URL(element string, format string) (string, error) {
+	e, err := cl.FindElement(element)
+	if err != nil {
+		return "", err
+	}
+	return cl.Config.URL(e, format)
+}
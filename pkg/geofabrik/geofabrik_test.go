@@ -0,0 +1,88 @@
+package geofabrik
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+// loadConfig load a config file with a default Client.
+func loadConfig(configFile string) (*Config, error) {
+	return NewClient().LoadConfig(configFile)
+}
+
+// memFiles is a ReadFile for config files in memory.
+type memFiles map[string]string
+
+func (m memFiles) ReadFile(filename string) ([]byte, error) {
+	content, ok := m[filename]
+	if !ok {
+		return nil, fmt.Errorf("open %s: %v", filename, os.ErrNotExist)
+	}
+	return []byte(content), nil
+}
+
+func TestClient_LoadConfig(t *testing.T) {
+	files := memFiles{
+		"base.yml":    "baseURL: https://my.base.url\nformats:\n  osm.pbf:\n    ext: osm.pbf\n    loc: .osm.pbf\nelements:\n  europe:\n    id: europe\n    files: [osm.pbf]\n",
+		"overlay.yml": "version: 1\ninclude: [base.yml]\nelements:\n  monaco:\n    id: monaco\n    parent: europe\n    files: [osm.pbf]\n",
+		"loop.yml":    "include: [loop.yml]\n",
+		"too-new.yml": "version: 999\n",
+		"missing.yml": "include: [nowhere.yml]\n",
+	}
+	tests := []struct {
+		name       string
+		configFile string
+		wantURL    string
+		wantErr    bool
+	}{
+		{name: "single file", configFile: "base.yml", wantURL: "https://my.base.url/europe.osm.pbf"},
+		{name: "include", configFile: "overlay.yml", wantURL: "https://my.base.url/europe/monaco.osm.pbf"},
+		{name: "include loop", configFile: "loop.yml", wantErr: true},
+		{name: "too new", configFile: "too-new.yml", wantErr: true},
+		{name: "missing include", configFile: "missing.yml", wantErr: true},
+		{name: "missing file", configFile: "nowhere.yml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient()
+			client.ReadFile = files.ReadFile
+			c, err := client.LoadConfig(tt.configFile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Client.LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if client.Config != c || c.Version != ConfigVersion {
+				t.Errorf("Client.LoadConfig() = %+v, want Config of version %d", c, ConfigVersion)
+			}
+			id := "europe"
+			if tt.configFile == "overlay.yml" {
+				id = "monaco"
+			}
+			got, err := client.URL(id, "osm.pbf")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.wantURL {
+				t.Errorf("Client.URL() = %v, want %v", got, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestClient_FindElement(t *testing.T) {
+	client := NewClient()
+	if _, err := client.FindElement("africa"); err == nil {
+		t.Errorf("Client.FindElement() must fail without config")
+	}
+	client.Config = &SampleConfigValidPtr
+	e, err := client.FindElement("africa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.ID != "africa" {
+		t.Errorf("Client.FindElement() = %+v, want africa", e)
+	}
+}
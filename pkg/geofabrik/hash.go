@@ -0,0 +1,102 @@
+package geofabrik
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+)
+
+// Verify download the checksum of element.format and control the file in opts.OutputDir.
+// It's false if format have no checksum.
+func (cl *Client) Verify(ctx context.Context, element string, format string, opts DownloadOptions) (bool, error) {
+	start := time.Now()
+	filename := LocalFile(opts.OutputDir, element, format)
+	ok, hash, _ := cl.Config.IsHashable(format)
+	if !ok {
+		cl.logf("No checksum provided for %s", filename)
+		return false, nil
+	}
+	myURL, err := cl.URL(element, hash)
+	if err != nil {
+		return false, err
+	}
+	hashFile := LocalFile(opts.OutputDir, element, hash)
+	if err = cl.DownloadFile(ctx, myURL, hashFile, DownloadOptions{DryRun: opts.DryRun}); err != nil {
+		return false, err
+	}
+	cl.debugf("Hashing %s", filename)
+	hashed, err := HashFileMD5(filename)
+	if err != nil {
+		return false, err
+	}
+	cl.debugf("MD5 : %s", hashed)
+	ret, err := controlHash(hashFile, hashed)
+	if err != nil {
+		return false, err
+	}
+	e := Event{Type: EventChecksumOK, Element: element, Format: format, URL: myURL, Bytes: fileSize(filename)}
+	if ret {
+		cl.logf("Checksum OK for %s", filename)
+	} else {
+		cl.logf("Checksum MISMATCH for %s", filename)
+		e.Type = EventChecksumMismatch
+	}
+	e.Duration = time.Since(start)
+	cl.event(e)
+	return ret, nil
+}
+
+func fileExist(filePath string) bool {
+	if _, err := os.Stat(filePath); err == nil {
+		return true
+	}
+	return false
+}
+
+// fileSize give the size of filePath, 0 if it not exist.
+func fileSize(filePath string) int64 {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// HashFileMD5 give the md5 of filePath, "" if it not exist.
+func HashFileMD5(filePath string) (string, error) {
+	var returnMD5String string
+	if fileExist(filePath) {
+		file, err := os.Open(filePath)
+		if err != nil {
+			return returnMD5String, err
+		}
+		defer file.Close()
+		hash := md5.New()
+
+		if _, err := io.Copy(hash, file); err != nil {
+			return returnMD5String, err
+		}
+		hashInBytes := hash.Sum(nil)[:16]
+		returnMD5String = hex.EncodeToString(hashInBytes)
+		return returnMD5String, nil
+	}
+	return returnMD5String, nil
+}
+
+// controlHash compare hash with the checksum in hashfile.
+func controlHash(hashfile string, hash string) (bool, error) {
+	if fileExist(hashfile) {
+		file, err := ioutil.ReadFile(hashfile)
+		if err != nil {
+			return false, err
+		}
+		filehash := strings.Split(string(file), " ")[0]
+		return strings.EqualFold(hash, filehash), nil
+	}
+	return false, nil
+}
@@ -0,0 +1,118 @@
+package geofabrik
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestHashFileMD5(t *testing.T) {
+	type args struct {
+		filePath string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{name: "Check with LICENSE file", args: args{filePath: "../../LICENSE"}, want: "65d26fcc2f35ea6a181ac777e42db1ea", wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HashFileMD5(tt.args.filePath)
+			if err != nil != tt.wantErr {
+				t.Errorf("HashFileMD5(%v) error = %v, wantErr %v", tt.args.filePath, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("HashFileMD5() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Benchmark_HashFileMD5_LICENSE(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		HashFileMD5("../../LICENSE")
+	}
+}
+
+func Benchmark_controlHash_LICENSE(b *testing.B) {
+	hash, _ := HashFileMD5("../../LICENSE")
+	hashfile := "/tmp/download-geofabrik-test.hash"
+	ioutil.WriteFile(hashfile, []byte(hash), 0644)
+	for n := 0; n < b.N; n++ {
+		controlHash(hashfile, hash)
+	}
+}
+
+func Test_controlHash(t *testing.T) {
+	type args struct {
+		hashfile string
+		hash     string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       bool
+		wantErr    bool
+		fileToHash string
+	}{
+		// TODO: Add test cases.
+		{name: "Check with LICENSE file", fileToHash: "../../LICENSE", args: args{hashfile: "/tmp/download-geofabrik-test.hash", hash: "65d26fcc2f35ea6a181ac777e42db1ea"}, want: true, wantErr: false},
+		{name: "Check with LICENSE file wrong hash", fileToHash: "../../LICENSE", args: args{hashfile: "/tmp/download-geofabrik-test.hash", hash: "65d26fcc2f35ea6a181ac777e42db1eb"}, want: false, wantErr: false},
+	}
+	for _, tt := range tests {
+		hash, _ := HashFileMD5(tt.fileToHash)
+		ioutil.WriteFile(tt.args.hashfile, []byte(hash), 0644)
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := controlHash(tt.args.hashfile, tt.args.hash)
+			if err != nil != tt.wantErr {
+				t.Errorf("controlHash() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("controlHash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Verify(t *testing.T) {
+	server, client := newTestServer(map[string]int{})
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tests := []struct {
+		name    string
+		format  string
+		content string
+		want    bool
+		wantErr bool
+	}{
+		{name: "checksum match", format: "osm.pbf", content: monacoPbf, want: true},
+		{name: "checksum mismatch", format: "osm.pbf", content: "corrupted", want: false},
+		{name: "no checksum for poly", format: "poly", content: "monaco", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ioutil.WriteFile(LocalFile(dir, "monaco", tt.format), []byte(tt.content), 0644)
+			got, err := client.Verify(context.Background(), "monaco", tt.format, DownloadOptions{OutputDir: dir})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Client.Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Client.Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := client.Verify(context.Background(), "missing", "osm.pbf", DownloadOptions{OutputDir: dir}); err == nil {
+		t.Errorf("Client.Verify() must fail when checksum is not found")
+	}
+}
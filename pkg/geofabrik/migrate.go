@@ -0,0 +1,55 @@
+package geofabrik
+
+import "fmt"
+
+// ConfigVersion is the version of config files written by this version.
+// Increase it and add a migration when Config, Format or Element change.
+const ConfigVersion = 1
+
+// migrations upgrade a Config from version i to version i+1.
+var migrations = []func(c *Config){
+	migrateV0,
+}
+
+// migrateV0 upgrade config files without version.
+// Old files may miss IDs, set meta or have twice the same format.
+func migrateV0(c *Config) {
+	for k, f := range c.Formats {
+		if f.ID == "" {
+			f.ID = k
+			c.Formats[k] = f
+		}
+	}
+	for k, e := range c.Elements {
+		if e.ID == "" {
+			e.ID = k
+		}
+		var formats []string
+		for _, f := range e.Formats {
+			if !stringInSlice(&f, &formats) {
+				formats = append(formats, f)
+			}
+		}
+		e.Formats = formats
+		if len(e.Formats) == 0 {
+			e.Meta = true
+		}
+		c.Elements[k] = e
+	}
+}
+
+// MigrateConfig upgrade c to ConfigVersion.
+// It's an error if c is newer than ConfigVersion.
+func MigrateConfig(c *Config) error {
+	if c.Version > ConfigVersion {
+		return fmt.Errorf("config version %d is too new, this download-geofabrik only support version %d, please upgrade it", c.Version, ConfigVersion)
+	}
+	if c.Version < 0 {
+		return fmt.Errorf("config version %d is not valid", c.Version)
+	}
+	for c.Version < ConfigVersion {
+		migrations[c.Version](c)
+		c.Version++
+	}
+	return nil
+}
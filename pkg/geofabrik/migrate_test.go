@@ -0,0 +1,59 @@
+package geofabrik
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_migrateV0(t *testing.T) {
+	c := &Config{
+		Formats: map[string]Format{
+			"osm.pbf": {Loc: "-latest.osm.pbf"},
+			"poly":    {ID: "poly", Loc: ".poly"},
+		},
+		Elements: map[string]Element{
+			"europe": {Name: "Europe", Formats: []string{"osm.pbf", "poly", "osm.pbf"}},
+			"us":     {ID: "us", Name: "United States of America", Parent: "north-america"},
+		},
+	}
+	want := &Config{
+		Formats: map[string]Format{
+			"osm.pbf": {ID: "osm.pbf", Loc: "-latest.osm.pbf"},
+			"poly":    {ID: "poly", Loc: ".poly"},
+		},
+		Elements: map[string]Element{
+			"europe": {ID: "europe", Name: "Europe", Formats: []string{"osm.pbf", "poly"}},
+			"us":     {ID: "us", Name: "United States of America", Parent: "north-america", Meta: true},
+		},
+	}
+	migrateV0(c)
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("migrateV0() = %+v, want %+v", c, want)
+	}
+}
+
+func TestMigrateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		wantErr bool
+	}{
+		{name: "no version", version: 0},
+		{name: "latest version", version: ConfigVersion},
+		{name: "too new", version: ConfigVersion + 1, wantErr: true},
+		{name: "negative", version: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{Version: tt.version}
+			err := MigrateConfig(c)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MigrateConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && c.Version != ConfigVersion {
+				t.Errorf("MigrateConfig() version = %d, want %d", c.Version, ConfigVersion)
+			}
+		})
+	}
+}
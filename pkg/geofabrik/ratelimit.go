@@ -0,0 +1,78 @@
+package geofabrik
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket limiting downloads to a rate in bytes by second.
+// A Limiter can be shared by downloads running in parallel,
+// the rate is then the total of all of them.
+type Limiter struct {
+	mu     sync.Mutex
+	rate   float64 // bytes by second
+	tokens float64 // available bytes, negative when reserved by waiting readers
+	last   time.Time
+	now    func() time.Time
+	sleep  func(ctx context.Context, d time.Duration) error
+}
+
+// NewLimiter make a Limiter of rate bytes by second.
+// It allows bursts of one second.
+func NewLimiter(rate int64) *Limiter {
+	return &Limiter{rate: float64(rate), tokens: float64(rate), now: time.Now, sleep: sleepContext}
+}
+
+// sleepContext wait d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// WaitN wait until n bytes can be read, or ctx is done.
+func (l *Limiter) WaitN(ctx context.Context, n int) error {
+	l.mu.Lock()
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.rate {
+			l.tokens = l.rate
+		}
+	}
+	l.last = now
+	l.tokens -= float64(n)
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+	if wait <= 0 {
+		return nil
+	}
+	return l.sleep(ctx, wait)
+}
+
+// limitedReader read from Reader at the rate of Limiter.
+type limitedReader struct {
+	io.Reader
+	ctx     context.Context
+	limiter *Limiter
+}
+
+func (r limitedReader) Read(p []byte) (int, error) {
+	if max := int(r.limiter.rate); len(p) > max && max > 0 {
+		p = p[:max] // never more than a burst
+	}
+	n, err := r.Reader.Read(p)
+	if n > 0 {
+		if werr := r.limiter.WaitN(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,101 @@
+package geofabrik
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock for Limiter, sleeping only move it.
+type fakeClock struct {
+	now   time.Time
+	slept time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.slept += d
+	c.now = c.now.Add(d)
+	return ctx.Err()
+}
+
+// newFakeLimiter make a Limiter of rate using a fakeClock.
+func newFakeLimiter(rate int64) (*Limiter, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2018, 5, 4, 0, 0, 0, 0, time.UTC)}
+	l := NewLimiter(rate)
+	l.now = clock.Now
+	l.sleep = clock.Sleep
+	return l, clock
+}
+
+func TestLimiter_WaitN(t *testing.T) {
+	l, clock := newFakeLimiter(100)
+	steps := []struct {
+		n       int
+		elapsed time.Duration // before WaitN
+		want    time.Duration // slept by WaitN
+	}{
+		{n: 100, want: 0},                            // burst
+		{n: 50, want: 500 * time.Millisecond},        // bucket is empty
+		{n: 50, elapsed: time.Second, want: 0},       // refilled
+		{n: 100, elapsed: 10 * time.Second, want: 0}, // burst is not bigger than rate
+		{n: 100, want: time.Second},                  // another reader share the same bucket
+		{n: 25, want: 250 * time.Millisecond},        // previous wait was spent
+	}
+	for i, step := range steps {
+		clock.now = clock.now.Add(step.elapsed)
+		clock.slept = 0
+		if err := l.WaitN(context.Background(), step.n); err != nil {
+			t.Fatal(err)
+		}
+		if clock.slept != step.want {
+			t.Errorf("step %d: Limiter.WaitN(%d) wait %v, want %v", i, step.n, clock.slept, step.want)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.WaitN(ctx, 1000); err != context.Canceled {
+		t.Errorf("Limiter.WaitN() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func Test_limitedReader(t *testing.T) {
+	l, clock := newFakeLimiter(10)
+	r := limitedReader{strings.NewReader(strings.Repeat("x", 35)), context.Background(), l}
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(content) != 35 || clock.slept != 2500*time.Millisecond {
+		t.Errorf("limitedReader read %d bytes in %v, want 35 bytes in 2.5s", len(content), clock.slept)
+	}
+}
+
+func TestClient_DownloadFile_limit(t *testing.T) {
+	server, client := newTestServer(map[string]int{})
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	var clock *fakeClock
+	client.Limiter, clock = newFakeLimiter(int64(len(monacoPbf)) / 2)
+	filename := filepath.Join(dir, "monaco.osm.pbf")
+	if err := client.DownloadFile(context.Background(), server.URL+"/europe/monaco.osm.pbf", filename, DownloadOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := ioutil.ReadFile(filename); string(content) != monacoPbf {
+		t.Errorf("Client.DownloadFile() write %q, want %q", content, monacoPbf)
+	}
+	if clock.slept != time.Second {
+		t.Errorf("Client.DownloadFile() wait %v, want 1s", clock.slept)
+	}
+}
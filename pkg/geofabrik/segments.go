@@ -0,0 +1,167 @@
+package geofabrik
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// rangeSize give the size of myURL if the server accept ranges, 0 if it doesn't.
+func (cl *Client) rangeSize(ctx context.Context, myURL string) (int64, error) {
+	req, err := http.NewRequest("HEAD", myURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	response, err := cl.httpClient().Do(req.WithContext(ctx))
+	if err != nil {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		return 0, Errorf(ErrNetwork, "Error while downloading %s - %v", myURL, err)
+	}
+	response.Body.Close()
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return 0, NotFoundError{myURL}
+	default:
+		return 0, Errorf(ErrHTTPStatus, "Error while downloading %v, server return code %d", myURL, response.StatusCode)
+	}
+	if response.Header.Get("Accept-Ranges") != "bytes" || response.ContentLength <= 0 {
+		return 0, nil
+	}
+	return response.ContentLength, nil
+}
+
+// offsetWriter write in a file from an offset.
+type offsetWriter struct {
+	f      *os.File
+	offset int64
+}
+
+func (w *offsetWriter) Write(p []byte) (int, error) {
+	n, err := w.f.WriteAt(p, w.offset)
+	w.offset += int64(n)
+	return n, err
+}
+
+// syncProgress is a Progress used by segments in parallel.
+type syncProgress struct {
+	mu sync.Mutex
+	Progress
+}
+
+func (p *syncProgress) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.Progress.Write(b)
+}
+
+// downloadSegments download myURL into fileName with opts.Segments ranges in parallel.
+// Segments are written in a preallocated fileName.part, which is removed if download fails.
+// done is false if the server doesn't accept ranges, then nothing is downloaded.
+func (cl *Client) downloadSegments(ctx context.Context, myURL string, fileName string, opts DownloadOptions) (done bool, err error) {
+	size, err := cl.rangeSize(ctx, myURL)
+	if err != nil || size == 0 {
+		return false, err
+	}
+	segments := int64(opts.Segments)
+	if segments > size {
+		segments = size
+	}
+	part := fileName + partSuffix
+	f, err := os.OpenFile(part, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0666)
+	if err != nil {
+		return true, fmt.Errorf("Error while creating %s - %v", part, err)
+	}
+	if err = f.Truncate(size); err != nil {
+		f.Close()
+		os.Remove(part)
+		return true, fmt.Errorf("Error while creating %s - %v", part, err)
+	}
+	var progress *syncProgress
+	if opts.Progress != nil {
+		if p := opts.Progress(size); p != nil {
+			progress = &syncProgress{Progress: p}
+		}
+	}
+	cl.debugf("Downloading %s in %d segments", fileName, segments)
+	segmentCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errs := make(chan error, segments)
+	var wg sync.WaitGroup
+	for i := int64(0); i < segments; i++ {
+		wg.Add(1)
+		go func(start int64, end int64) {
+			defer wg.Done()
+			if err := cl.downloadRange(segmentCtx, myURL, f, start, end, progress); err != nil {
+				errs <- err
+				cancel() // stop other segments
+			}
+		}(i*size/segments, (i+1)*size/segments-1)
+	}
+	wg.Wait()
+	close(errs)
+	err = <-errs
+	if progress != nil {
+		progress.Finish()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(part) // segments can't be continued
+		if ctx.Err() != nil {
+			return true, ctx.Err()
+		}
+		return true, err
+	}
+	if err = os.Rename(part, fileName); err != nil {
+		return true, err
+	}
+	cl.logf("%s downloaded.", fileName)
+	cl.debugf("%d bytes downloaded.", size)
+	return true, nil
+}
+
+// downloadRange download bytes from start to end of myURL at the same place in f.
+func (cl *Client) downloadRange(ctx context.Context, myURL string, f *os.File, start int64, end int64, progress *syncProgress) error {
+	req, err := http.NewRequest("GET", myURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, end))
+	response, err := cl.httpClient().Do(req.WithContext(ctx))
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return Errorf(ErrNetwork, "Error while downloading %s - %v", myURL, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusPartialContent {
+		return Errorf(ErrHTTPStatus, "Error while downloading %v bytes %d-%d, server return code %d", myURL, start, end, response.StatusCode)
+	}
+	var body io.Reader = networkReader{response.Body, myURL}
+	if cl.Limiter != nil {
+		body = limitedReader{body, ctx, cl.Limiter}
+	}
+	var output io.Writer = &offsetWriter{f: f, offset: start}
+	if progress != nil {
+		output = io.MultiWriter(output, progress)
+	}
+	n, err := io.Copy(output, io.LimitReader(body, end-start+1))
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	if n != end-start+1 {
+		return Errorf(ErrNetwork, "Error while downloading %v bytes %d-%d, only %d bytes received", myURL, start, end, n)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package geofabrik
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestClient_DownloadFile_segments(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		segments int
+		wantGets int // GET requests
+		wantErr  error
+	}{
+		{name: "1 segment", path: "/europe/monaco.osm.pbf", segments: 1, wantGets: 1},
+		{name: "2 segments", path: "/europe/monaco.osm.pbf", segments: 2, wantGets: 2},
+		{name: "5 segments", path: "/europe/monaco.osm.pbf", segments: 5, wantGets: 5},
+		{name: "more segments than bytes", path: "/europe/monaco.osm.pbf", segments: 100, wantGets: len(monacoPbf)},
+		{name: "no ranges", path: "/europe/norange.osm.pbf", segments: 4, wantGets: 1},
+		{name: "not found", path: "/europe/missing.osm.pbf", segments: 4, wantErr: ErrHTTPStatus},
+		{name: "error", path: "/europe/error.osm.pbf", segments: 4, wantErr: ErrHTTPStatus},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits := make(map[string]int)
+			server, client := newTestServer(hits)
+			defer server.Close()
+			dir, err := ioutil.TempDir("", "download-geofabrik")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			progress := new(fakeProgress)
+			opts := DownloadOptions{Segments: tt.segments, Progress: func(size int64) Progress {
+				if size != int64(len(monacoPbf)) {
+					t.Errorf("Progress() size = %d, want %d", size, len(monacoPbf))
+				}
+				return progress
+			}}
+			filename := filepath.Join(dir, "monaco.osm.pbf")
+			err = client.DownloadFile(context.Background(), server.URL+tt.path, filename, opts)
+			if Cause(err) != tt.wantErr {
+				t.Fatalf("Client.DownloadFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if fileExist(filename + partSuffix) {
+				t.Errorf("Client.DownloadFile() must remove %s", filename+partSuffix)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if content, _ := ioutil.ReadFile(filename); string(content) != monacoPbf {
+				t.Errorf("Client.DownloadFile() write %q, want %q", content, monacoPbf)
+			}
+			heads := 0
+			if tt.segments > 1 {
+				heads = 1
+			}
+			if hits[tt.path] != heads+tt.wantGets {
+				t.Errorf("Client.DownloadFile() made %d requests, want %d", hits[tt.path], heads+tt.wantGets)
+			}
+			if progress.Len() != len(monacoPbf) || !progress.finished {
+				t.Errorf("Progress got %d bytes, finished = %v", progress.Len(), progress.finished)
+			}
+		})
+	}
+}
+
+func TestClient_Download_segmentsChecksum(t *testing.T) {
+	for _, element := range []string{"monaco", "corrupt"} {
+		t.Run(element, func(t *testing.T) {
+			server, client := newTestServer(make(map[string]int))
+			defer server.Close()
+			dir, err := ioutil.TempDir("", "download-geofabrik")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			err = client.Download(context.Background(), element, []string{"osm.pbf"}, DownloadOptions{OutputDir: dir, Check: true, Segments: 3})
+			if mismatch := Cause(err) == ErrChecksumMismatch; mismatch != (element == "corrupt") {
+				t.Errorf("Client.Download() error = %v, want a mismatch: %v", err, element == "corrupt")
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// proxySchemes are proxies supported by http.Transport.
+var proxySchemes = []string{"http", "https", "socks5"}
+
+// proxyURL give the proxy set with flags, nil if none.
+// --proxy-http and --proxy-sock5 may be only proxy_address:port,
+// --proxy-user and --proxy-pass are used if the proxy URL have no credentials.
+func proxyURL() (*url.URL, error) {
+	raw, scheme := *fProxy, "http"
+	switch {
+	case *fProxy != "":
+	case *fProxyHTTP != "":
+		raw = *fProxyHTTP
+	case *fProxySock5 != "":
+		raw, scheme = *fProxySock5, "socks5"
+	default:
+		return nil, nil
+	}
+	if !strings.Contains(raw, "://") {
+		raw = scheme + "://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("Wrong proxy url %s, please use format [scheme://][user:password@]proxy_address:port", raw)
+	}
+	if !stringInSlice(&u.Scheme, &proxySchemes) {
+		return nil, fmt.Errorf("Wrong proxy url %s, scheme must be one of %s", raw, strings.Join(proxySchemes, ", "))
+	}
+	if u.User == nil && *fProxyUser != "" {
+		u.User = url.UserPassword(*fProxyUser, *fProxyPass)
+	}
+	return u, nil
+}
+
+// proxyFunc give the proxy of each request URL, nil for a direct connection.
+// Proxy set with flags is used first, then HTTP_PROXY and HTTPS_PROXY environment variables.
+// Hosts in NO_PROXY are always reached directly.
+func proxyFunc() (func(*url.URL) (*url.URL, error), error) {
+	config := httpproxy.FromEnvironment()
+	proxy, err := proxyURL()
+	if err != nil {
+		return nil, err
+	}
+	if proxy != nil {
+		config = &httpproxy.Config{HTTPProxy: proxy.String(), HTTPSProxy: proxy.String(), NoProxy: config.NoProxy}
+	}
+	return config.ProxyFunc(), nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fixturePath give the file used to store u in dir.
+// Pages are stored as dir/host/path, directories (path ending by /) use index.html
+// like http.FileServer do, so a recorded dir can be served as is.
+func fixturePath(dir string, u *url.URL) string {
+	p := u.Path
+	if p == "" || strings.HasSuffix(p, "/") {
+		p += "index.html"
+	}
+	return filepath.Join(dir, u.Host, filepath.FromSlash(p))
+}
+
+// recordPage save the body of res into dir.
+// res.Body is replaced, so it still can be read by the crawler.
+func recordPage(dir string, u *url.URL, res *http.Response) error {
+	if res.StatusCode != http.StatusOK {
+		return nil // Only keep valid pages, others will be 404 on replay
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if err = res.Body.Close(); err != nil {
+		return err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+	filename := fixturePath(dir, u)
+	if err = os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, body, 0644)
+}
+
+// fetch get myURL with client, like gocrawl do.
+func fetch(client *http.Client, myURL string, userAgent string, headRequest bool) (*http.Response, error) {
+	method := "GET"
+	if headRequest {
+		method = "HEAD"
+	}
+	req, err := http.NewRequest(method, myURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	return client.Do(req)
+}
+
+// Replayer serve pages recorded with recordPage from a local httptest server.
+type Replayer struct {
+	server *httptest.Server
+}
+
+// newReplayer start a local server on dir.
+func newReplayer(dir string) (*Replayer, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+	return &Replayer{server: httptest.NewServer(http.FileServer(http.Dir(dir)))}, nil
+}
+
+// URL give the local URL serving u.
+func (r *Replayer) URL(u *url.URL) string {
+	return r.server.URL + "/" + u.Host + u.EscapedPath()
+}
+
+// Fetch get u from the local server instead of the real one.
+func (r *Replayer) Fetch(u *url.URL, userAgent string, headRequest bool) (*http.Response, error) {
+	res, err := fetch(http.DefaultClient, r.URL(u), userAgent, headRequest)
+	if err != nil {
+		return nil, err
+	}
+	res.Request.URL = u // Crawler must see the original URL
+	return res, nil
+}
+
+// Close stop the local server.
+func (r *Replayer) Close() {
+	r.server.Close()
+}
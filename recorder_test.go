@@ -0,0 +1,174 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+func Test_fixturePath(t *testing.T) {
+	tests := []struct {
+		name  string
+		myURL string
+		want  string
+	}{
+		{name: "html page", myURL: "https://download.geofabrik.de/asia/japan.html", want: "testdata/download.geofabrik.de/asia/japan.html"},
+		{name: "directory", myURL: "https://download.openstreetmap.fr/extracts/", want: "testdata/download.openstreetmap.fr/extracts/index.html"},
+		{name: "root without /", myURL: "https://download.geofabrik.de", want: "testdata/download.geofabrik.de/index.html"},
+		{name: "php page", myURL: "http://be.gis-lab.info/project/osm_dump/iframe.php", want: "testdata/be.gis-lab.info/project/osm_dump/iframe.php"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, _ := url.Parse(tt.myURL)
+			if got := fixturePath("testdata", u); got != tt.want {
+				t.Errorf("fixturePath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_recordPage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik-record")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tests := []struct {
+		name     string
+		myURL    string
+		status   int
+		body     string
+		wantFile bool
+	}{
+		{name: "200 is recorded", myURL: "https://download.geofabrik.de/europe.html", status: 200, body: "<html>europe</html>", wantFile: true},
+		{name: "404 is not recorded", myURL: "https://download.geofabrik.de/nowhere.html", status: 404, body: "not found", wantFile: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, _ := url.Parse(tt.myURL)
+			res := &http.Response{StatusCode: tt.status, Body: ioutil.NopCloser(strings.NewReader(tt.body))}
+			if err := recordPage(dir, u, res); err != nil {
+				t.Fatalf("recordPage() error = %v", err)
+			}
+			// Body must still be readable by the crawler
+			body, _ := ioutil.ReadAll(res.Body)
+			if string(body) != tt.body {
+				t.Errorf("recordPage() body = %s, want %s", body, tt.body)
+			}
+			saved, err := ioutil.ReadFile(fixturePath(dir, u))
+			if (err == nil) != tt.wantFile {
+				t.Errorf("recordPage() file saved = %v, want %v", err == nil, tt.wantFile)
+			}
+			if tt.wantFile && string(saved) != tt.body {
+				t.Errorf("recordPage() saved = %s, want %s", saved, tt.body)
+			}
+		})
+	}
+}
+
+func TestReplayer_Fetch(t *testing.T) {
+	r, err := newReplayer("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	tests := []struct {
+		name       string
+		myURL      string
+		head       bool
+		wantStatus int
+		wantInBody string
+	}{
+		{name: "geofabrik page", myURL: "https://download.geofabrik.de/asia/japan/shikoku.html", wantStatus: 200, wantInBody: "shikoku-latest.osm.pbf"},
+		{name: "osmfr directory", myURL: "https://download.openstreetmap.fr/extracts/merge/", wantStatus: 200, wantInBody: "fiji.osm.pbf"},
+		{name: "head request", myURL: "https://download.geofabrik.de/south-america.html", head: true, wantStatus: 200},
+		{name: "not recorded", myURL: "https://download.geofabrik.de/europe.html", wantStatus: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, _ := url.Parse(tt.myURL)
+			res, err := r.Fetch(u, "test", tt.head)
+			if err != nil {
+				t.Fatalf("Replayer.Fetch() error = %v", err)
+			}
+			defer res.Body.Close()
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("Replayer.Fetch() status = %v, want %v", res.StatusCode, tt.wantStatus)
+			}
+			if res.Request.URL.String() != tt.myURL {
+				t.Errorf("Replayer.Fetch() URL = %v, want %v", res.Request.URL, tt.myURL)
+			}
+			body, _ := ioutil.ReadAll(res.Body)
+			if !strings.Contains(string(body), tt.wantInBody) {
+				t.Errorf("Replayer.Fetch() body don't contain %v", tt.wantInBody)
+			}
+		})
+	}
+}
+
+func Test_newReplayer(t *testing.T) {
+	tests := []struct {
+		name    string
+		dir     string
+		wantErr bool
+	}{
+		{name: "testdata", dir: "testdata", wantErr: false},
+		{name: "not exist", dir: "./this_dir_not_exists", wantErr: true},
+		{name: "not a dir", dir: "./LICENSE", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newReplayer(tt.dir)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newReplayer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != nil {
+				got.Close()
+			}
+		})
+	}
+}
+
+// Check recorded pages can be served by the replayer
+func Test_recordThenReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik-replay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html>" + r.URL.Path + "</html>"))
+	}))
+	defer upstream.Close()
+	for _, p := range []string{"/", "/europe.html", "/extracts/merge/"} {
+		res, err := http.Get(upstream.URL + p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := recordPage(dir, res.Request.URL, res); err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+	}
+	r, err := newReplayer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	for _, p := range []string{"/", "/europe.html", "/extracts/merge/"} {
+		u, _ := url.Parse(upstream.URL + p)
+		res, err := r.Fetch(u, "test", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if string(body) != "<html>"+p+"</html>" {
+			t.Errorf("replay %s = %s", p, body)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// RenameRule change the ID of an element found under Parent.
+// It's used when a service have the same ID in different places.
+type RenameRule struct {
+	ID     string `yaml:"id"`
+	Parent string `yaml:"parent"`
+	NewID  string `yaml:"newid"`
+	Name   string `yaml:"name,omitempty"` // also change Name if not empty
+}
+
+// ReparentRule move elements under Parent.
+type ReparentRule struct {
+	Parent string   `yaml:"parent"`
+	IDs    []string `yaml:"ids"`
+}
+
+// Rules are fixups applied by the generator.
+// Rename rules are applied while parsing pages, because renamed elements
+// may share the same ID. Others are applied after crawling.
+type Rules struct {
+	Rename   []RenameRule      `yaml:"rename,omitempty"`
+	Reparent []ReparentRule    `yaml:"reparent,omitempty"`
+	File     map[string]string `yaml:"file,omitempty"`   // ID: file used in URLs
+	Inject   []Element         `yaml:"inject,omitempty"` // added if not crawled
+}
+
+// defaultRulesFile give the rules file shipped for service.
+func defaultRulesFile(service string) string {
+	if service == "geofabrik-internal" {
+		service = "geofabrik" // same elements
+	}
+	return "./" + service + ".rules.yml"
+}
+
+// loadRules read rulesFile.
+// Shipped rules are embedded, they are used if rulesFile is not found,
+// as config refresh generate configs from any directory.
+// Otherwise if mustExist is false, a missing file give empty rules.
+func loadRules(rulesFile string, mustExist bool) (*Rules, error) {
+	filename, _ := filepath.Abs(rulesFile)
+	fileContent, err := ioutil.ReadFile(filename)
+	if err != nil {
+		embedded, ok := embeddedConfigs[filepath.Clean(rulesFile)]
+		switch {
+		case ok && os.IsNotExist(err):
+			fileContent = []byte(embedded) // shipped rules
+		case os.IsNotExist(err) && !mustExist:
+			return new(Rules), nil
+		default:
+			return nil, err
+		}
+	}
+	rules := new(Rules)
+	if err := yaml.Unmarshal(fileContent, rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// rename apply rename rules on e.
+func (r *Rules) rename(e *Element) {
+	if r == nil {
+		return
+	}
+	for _, rule := range r.Rename {
+		if rule.ID == e.ID && rule.Parent == e.Parent {
+			e.ID = rule.NewID
+			if rule.Name != "" {
+				e.Name = rule.Name
+			}
+			return
+		}
+	}
+}
+
+// Apply reparent, file and inject rules on elements.
+func (r *Rules) Apply(elements ElementSlice) {
+	if r == nil {
+		return
+	}
+	for _, rule := range r.Reparent {
+		for _, id := range rule.IDs {
+			if e, ok := elements[id]; ok {
+				e.Parent = rule.Parent
+				elements[id] = e
+			}
+		}
+	}
+	for id, file := range r.File {
+		if e, ok := elements[id]; ok {
+			e.File = file
+			elements[id] = e
+		}
+	}
+	for _, inject := range r.Inject {
+		if _, ok := elements[inject.ID]; !ok {
+			elements[inject.ID] = inject
+		}
+	}
+}
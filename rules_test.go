@@ -0,0 +1,142 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/gocrawl"
+	"github.com/PuerkitoBio/goquery"
+)
+
+func Test_loadRules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	badRules := filepath.Join(dir, "bad.rules.yml")
+	ioutil.WriteFile(badRules, []byte("rename: [this is not a rule"), 0644)
+	tests := []struct {
+		name      string
+		rulesFile string
+		mustExist bool
+		wantEmpty bool
+		wantErr   bool
+	}{
+		{name: "geofabrik default rules", rulesFile: defaultRulesFile("geofabrik"), mustExist: true},
+		{name: "geofabrik-internal use geofabrik rules", rulesFile: defaultRulesFile("geofabrik-internal"), mustExist: true},
+		{name: "openstreetmap.fr have no rules", rulesFile: defaultRulesFile("openstreetmap.fr"), wantEmpty: true},
+		{name: "missing rules file", rulesFile: "./this_file_not_exists", mustExist: true, wantErr: true},
+		{name: "not yaml", rulesFile: badRules, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadRules(tt.rulesFile, tt.mustExist)
+			if err != nil != tt.wantErr {
+				t.Errorf("loadRules() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != nil && reflect.DeepEqual(*got, Rules{}) != tt.wantEmpty {
+				t.Errorf("loadRules() = %+v, wantEmpty %v", got, tt.wantEmpty)
+			}
+		})
+	}
+}
+
+func TestRules_rename(t *testing.T) {
+	rules, err := loadRules(defaultRulesFile("geofabrik"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		rules *Rules
+		e     Element
+		want  Element
+	}{
+		{name: "georgia in europe", rules: rules, e: Element{ID: "georgia", Name: "Georgia", Parent: "europe"}, want: Element{ID: "georgia-eu", Name: "Georgia (Europe country)", Parent: "europe"}},
+		{name: "georgia in us", rules: rules, e: Element{ID: "georgia", Name: "Georgia", Parent: "us"}, want: Element{ID: "georgia-us", Name: "Georgia (US State)", Parent: "us"}},
+		{name: "no rule", rules: rules, e: Element{ID: "france", Name: "France", Parent: "europe"}, want: Element{ID: "france", Name: "France", Parent: "europe"}},
+		{name: "nil rules", rules: nil, e: Element{ID: "georgia", Parent: "europe"}, want: Element{ID: "georgia", Parent: "europe"}},
+		{name: "keep name", rules: &Rules{Rename: []RenameRule{{ID: "a", Parent: "b", NewID: "c"}}}, e: Element{ID: "a", Name: "A", Parent: "b"}, want: Element{ID: "c", Name: "A", Parent: "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rules.rename(&tt.e)
+			if !reflect.DeepEqual(tt.e, tt.want) {
+				t.Errorf("Rules.rename() = %+v, want %+v", tt.e, tt.want)
+			}
+		})
+	}
+}
+
+func TestRules_Apply(t *testing.T) {
+	rules, err := loadRules(defaultRulesFile("geofabrik"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elements := ElementSlice{
+		"north-america": {ID: "north-america"},
+		"alabama":       {ID: "alabama", Parent: "north-america"},
+		"georgia-us":    {ID: "georgia-us", Parent: "us"},
+		"georgia-eu":    {ID: "georgia-eu", Parent: "europe"},
+		"france":        {ID: "france", Parent: "europe"},
+	}
+	want := ElementSlice{
+		"north-america": {ID: "north-america"},
+		"alabama":       {ID: "alabama", Parent: "us"},
+		"georgia-us":    {ID: "georgia-us", Parent: "us", File: "georgia"},
+		"georgia-eu":    {ID: "georgia-eu", Parent: "europe", File: "georgia"},
+		"france":        {ID: "france", Parent: "europe"},
+		"us":            {ID: "us", Name: "United States of America", Parent: "north-america", Meta: true},
+	}
+	rules.Apply(elements)
+	if !reflect.DeepEqual(elements, want) {
+		t.Errorf("Rules.Apply() = %+v, want %+v", elements, want)
+	}
+	// Injected elements don't replace crawled ones
+	crawled := ElementSlice{"us": {ID: "us", Name: "US", Formats: []string{"osm.pbf"}}}
+	rules.Apply(crawled)
+	if crawled["us"].Name != "US" {
+		t.Errorf("Rules.Apply() replaced crawled us by %+v", crawled["us"])
+	}
+	var nilRules *Rules
+	nilRules.Apply(crawled) // should not panic
+}
+
+// Both georgia are kept when parsing pages with rules
+func TestExt_parseGeofabrik_rules(t *testing.T) {
+	rules, err := loadRules(defaultRulesFile("geofabrik"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := &Ext{
+		DefaultExtender: new(gocrawl.DefaultExtender),
+		Elements:        ElementSlice{},
+		Rules:           rules,
+	}
+	pages := []string{
+		strings.NewReplacer("shikoku", "georgia", "Shikoku", "Georgia", "../japan.html", "../us.html").Replace(geofabrikShikokuHTML),
+		strings.NewReplacer("shikoku", "georgia", "Shikoku", "Georgia", "../japan.html", "../europe.html").Replace(geofabrikShikokuHTML),
+	}
+	for _, page := range pages {
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(page))
+		if err != nil {
+			t.Fatal(err)
+		}
+		e.parseGeofabrik(nil, nil, doc)
+	}
+	e.Rules.Apply(e.Elements)
+	for id, parent := range map[string]string{"georgia-us": "us", "georgia-eu": "europe", "us": "north-america"} {
+		if got := e.Elements[id]; got.ID != id || got.Parent != parent {
+			t.Errorf("Ext.parseGeofabrik() %s = %+v, want parent %s", id, got, parent)
+		}
+	}
+	if _, ok := e.Elements["georgia"]; ok {
+		t.Errorf("Ext.parseGeofabrik() georgia should be renamed")
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
+)
+
+func Test_settingsFile(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{name: "settings env", env: map[string]string{"DOWNLOAD_GEOFABRIK_SETTINGS": "/tmp/my.yml", "XDG_CONFIG_HOME": "/xdg", "HOME": "/home/me"}, want: "/tmp/my.yml"},
+		{name: "xdg", env: map[string]string{"DOWNLOAD_GEOFABRIK_SETTINGS": "", "XDG_CONFIG_HOME": "/xdg", "HOME": "/home/me"}, want: "/xdg/download-geofabrik/settings.yml"},
+		{name: "home", env: map[string]string{"DOWNLOAD_GEOFABRIK_SETTINGS": "", "XDG_CONFIG_HOME": "", "HOME": "/home/me"}, want: "/home/me/.config/download-geofabrik/settings.yml"},
+		{name: "nothing", env: map[string]string{"DOWNLOAD_GEOFABRIK_SETTINGS": "", "XDG_CONFIG_HOME": "", "HOME": ""}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				old := os.Getenv(k)
+				os.Setenv(k, v)
+				defer os.Setenv(k, old)
+			}
+			if got := settingsFile(); got != tt.want {
+				t.Errorf("settingsFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_loadSettings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	good := filepath.Join(dir, "settings.yml")
+	ioutil.WriteFile(good, []byte("service: openstreetmap.fr\noutput-dir: /data/osm\njobs: 4\ncheck: false\nauth:\n  geofabrik:\n    - hosts: [mirror.example.com]\n      token: secret\n"), 0644)
+	bad := filepath.Join(dir, "bad.yml")
+	ioutil.WriteFile(bad, []byte("jobs: [not a number"), 0644)
+	no := false
+	tests := []struct {
+		name    string
+		file    string
+		want    *Settings
+		wantErr bool
+	}{
+		{name: "settings", file: good, want: &Settings{Service: "openstreetmap.fr", OutputDir: "/data/osm", Jobs: 4, Check: &no, Auth: map[string][]geofabrik.Auth{"geofabrik": {{Hosts: []string{"mirror.example.com"}, Token: "secret"}}}}},
+		{name: "missing file", file: filepath.Join(dir, "this_file_not_exists"), want: &Settings{}},
+		{name: "no file", file: "", want: &Settings{}},
+		{name: "not yaml", file: bad, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadSettings(tt.file)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("loadSettings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("loadSettings() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSettings_defaults(t *testing.T) {
+	yes := true
+	no := false
+	tests := []struct {
+		name     string
+		settings Settings
+		want     map[string]string
+	}{
+		{name: "empty", settings: Settings{}, want: map[string]string{}},
+		{
+			name:     "all",
+			settings: Settings{Service: "gislab", Config: "/etc/gislab.yml", ProxyHTTP: "proxy:3128", OutputDir: "/data", Timeout: "5m", LogFormat: "json", LimitRate: "5M", Jobs: 2, Check: &no, Resume: &yes, Verbose: &yes},
+			want: map[string]string{
+				"service":    "gislab",
+				"config":     "/etc/gislab.yml",
+				"proxy-http": "proxy:3128",
+				"output-dir": "/data",
+				"timeout":    "5m",
+				"log-format": "json",
+				"limit-rate": "5M",
+				"jobs":       "2",
+				"check":      "false",
+				"resume":     "true",
+				"verbose":    "true",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.settings.defaults(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Settings.defaults() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// subtreeSeeds give pages to crawl for element root and its children.
+func subtreeSeeds(c *Config, root string) ([]string, error) {
+	myElem, err := c.FindElement(root)
+	if err != nil {
+		return nil, err
+	}
+	if parentCycle(c, root) {
+		return nil, fmt.Errorf("%s have a cycle in parents", root)
+	}
+	preURL, err := c.ElementURL(myElem)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(preURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Host {
+	case "download.geofabrik.de":
+		// europe.html and europe/*
+		return []string{preURL + ".html"}, nil
+	case "download.openstreetmap.fr":
+		// extracts/europe/ and polygons/europe/
+		seeds := []string{preURL + "/"}
+		if poly, ok := c.Formats["poly"]; ok && poly.BasePath != "" {
+			polyURL, err := c.ElementURL(myElem, poly.BasePath)
+			if err != nil {
+				return nil, err
+			}
+			p, err := url.Parse(polyURL)
+			if err != nil {
+				return nil, err
+			}
+			p.Path = path.Clean(p.Path)
+			seeds = append(seeds, p.String()+"/")
+		}
+		return seeds, nil
+	}
+	return nil, fmt.Errorf("--root is not supported for %s", u.Host)
+}
+
+// inSubtree is true if myURL is one of seeds or a child page of one of seeds.
+func inSubtree(seeds []string, myURL *url.URL) bool {
+	page := myURL.Host + myURL.Path
+	for _, seed := range seeds {
+		s, err := url.Parse(seed)
+		if err != nil {
+			continue
+		}
+		root := s.Host + s.Path
+		dir := strings.TrimSuffix(root, ".html")
+		if !strings.HasSuffix(dir, "/") {
+			dir += "/"
+		}
+		if page == root || strings.HasPrefix(page, dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// isDescendant is true if id is root or if root is one of its parents.
+func isDescendant(elements ElementSlice, fallback ElementSlice, id string, root string) bool {
+	visited := map[string]bool{}
+	for id != "" && !visited[id] {
+		if id == root {
+			return true
+		}
+		visited[id] = true
+		if e, ok := elements[id]; ok {
+			id = e.Parent
+		} else {
+			id = fallback[id].Parent
+		}
+	}
+	return false
+}
+
+// mergeSubtree replace root and its children in old by crawled ones.
+// root is kept from old if it was not crawled.
+// Elements of crawled outside root are ignored.
+func mergeSubtree(old ElementSlice, crawled ElementSlice, root string) ElementSlice {
+	res := make(ElementSlice)
+	for id, e := range old {
+		if id == root {
+			if _, ok := crawled[root]; ok {
+				continue
+			}
+		} else if isDescendant(old, nil, id, root) {
+			continue
+		}
+		res[id] = e
+	}
+	for id, e := range crawled {
+		if isDescendant(crawled, old, id, root) {
+			res[id] = e
+		}
+	}
+	return res
+}
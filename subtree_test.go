@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func Test_subtreeSeeds(t *testing.T) {
+	geofabrik, err := loadConfig("./geofabrik.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	osmfr, err := loadConfig("./openstreetmap.fr.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gislab, err := loadConfig("./gislab.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		c       *Config
+		root    string
+		want    []string
+		wantErr bool
+	}{
+		{name: "geofabrik europe", c: geofabrik, root: "europe", want: []string{"https://download.geofabrik.de/europe.html"}},
+		{name: "geofabrik georgia-us", c: geofabrik, root: "georgia-us", want: []string{"https://download.geofabrik.de/north-america/us/georgia.html"}},
+		{
+			name: "osmfr france",
+			c:    osmfr,
+			root: "france",
+			want: []string{"https://download.openstreetmap.fr/extracts/europe/france/", "https://download.openstreetmap.fr/polygons/europe/france/"},
+		},
+		{name: "gislab not supported", c: gislab, root: "AM", wantErr: true},
+		{name: "not in config", c: geofabrik, root: "nowhere", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := subtreeSeeds(tt.c, tt.root)
+			if err != nil != tt.wantErr {
+				t.Errorf("subtreeSeeds() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("subtreeSeeds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_inSubtree(t *testing.T) {
+	seeds := []string{"https://download.geofabrik.de/europe.html", "https://download.openstreetmap.fr/extracts/europe/"}
+	tests := []struct {
+		myURL string
+		want  bool
+	}{
+		{myURL: "https://download.geofabrik.de/europe.html", want: true},
+		{myURL: "https://download.geofabrik.de/europe/france.html", want: true},
+		{myURL: "https://download.geofabrik.de/europe/france/alsace.html", want: true},
+		{myURL: "https://download.geofabrik.de/asia.html", want: false},
+		{myURL: "https://download.geofabrik.de/europe-updates/", want: false},
+		{myURL: "https://download.geofabrik.de/index.html", want: false},
+		{myURL: "https://download.openstreetmap.fr/extracts/europe/", want: true},
+		{myURL: "https://download.openstreetmap.fr/extracts/europe/france/", want: true},
+		{myURL: "https://download.openstreetmap.fr/extracts/asia/", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.myURL, func(t *testing.T) {
+			u, _ := url.Parse(tt.myURL)
+			if got := inSubtree(seeds, u); got != tt.want {
+				t.Errorf("inSubtree(%v) = %v, want %v", tt.myURL, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_mergeSubtree(t *testing.T) {
+	old := ElementSlice{
+		"asia":    {ID: "asia", Formats: []string{"osm.pbf"}},
+		"japan":   {ID: "japan", Parent: "asia", Formats: []string{"osm.pbf"}},
+		"shikoku": {ID: "shikoku", Parent: "japan", Formats: []string{"osm.pbf"}},
+		"removed": {ID: "removed", Parent: "japan"},
+		"europe":  {ID: "europe", Formats: []string{"osm.pbf"}},
+		"france":  {ID: "france", Parent: "europe"},
+	}
+	tests := []struct {
+		name    string
+		crawled ElementSlice
+		root    string
+		want    ElementSlice
+	}{
+		{
+			name: "root crawled",
+			crawled: ElementSlice{
+				"japan":   {ID: "japan", Parent: "asia", Formats: []string{"osm.pbf", "poly"}},
+				"shikoku": {ID: "shikoku", Parent: "japan", Formats: []string{"osm.pbf", "poly"}},
+				"kyushu":  {ID: "kyushu", Parent: "japan", Formats: []string{"osm.pbf"}},
+				"us":      {ID: "us", Meta: true, Parent: "north-america"}, // outside root
+			},
+			root: "japan",
+			want: ElementSlice{
+				"asia":    {ID: "asia", Formats: []string{"osm.pbf"}},
+				"japan":   {ID: "japan", Parent: "asia", Formats: []string{"osm.pbf", "poly"}},
+				"shikoku": {ID: "shikoku", Parent: "japan", Formats: []string{"osm.pbf", "poly"}},
+				"kyushu":  {ID: "kyushu", Parent: "japan", Formats: []string{"osm.pbf"}},
+				"europe":  {ID: "europe", Formats: []string{"osm.pbf"}},
+				"france":  {ID: "france", Parent: "europe"},
+			},
+		},
+		{
+			name: "root not crawled is kept",
+			crawled: ElementSlice{
+				"shikoku": {ID: "shikoku", Parent: "japan", Formats: []string{"poly"}},
+			},
+			root: "japan",
+			want: ElementSlice{
+				"asia":    {ID: "asia", Formats: []string{"osm.pbf"}},
+				"japan":   {ID: "japan", Parent: "asia", Formats: []string{"osm.pbf"}},
+				"shikoku": {ID: "shikoku", Parent: "japan", Formats: []string{"poly"}},
+				"europe":  {ID: "europe", Formats: []string{"osm.pbf"}},
+				"france":  {ID: "france", Parent: "europe"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeSubtree(old, tt.crawled, tt.root); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeSubtree() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerate_root(t *testing.T) {
+	*fQuiet = true
+	*fService = "geofabrik"
+	*gReplay = "testdata"
+	*gRoot = "japan"
+	defer func() {
+		*gReplay = ""
+		*gRoot = ""
+	}()
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configfile := filepath.Join(dir, "geofabrik.yml")
+	existing := ElementSlice{
+		"asia":    {ID: "asia", Name: "Asia"},
+		"japan":   {ID: "japan", Name: "Japan", Parent: "asia"},
+		"kanto":   {ID: "kanto", Name: "Kanto", Parent: "japan"}, // not in testdata anymore
+		"europe":  {ID: "europe", Name: "Europe"},
+		"monaco":  {ID: "monaco", Name: "Monaco", Parent: "europe"},
+		"vatican": {ID: "vatican", Name: "Vatican", Parent: "italy"}, // parent missing, must be kept
+	}
+	out, err := existing.Generate(&Config{BaseURL: "https://download.geofabrik.de", Formats: map[string]format{"osm.pbf": {ID: "osm.pbf", Loc: "-latest.osm.pbf"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(configfile, out, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Generate(context.Background(), configfile); err != nil {
+		t.Fatal(err)
+	}
+	c, err := loadConfig(configfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for id := range c.Elements {
+		got = append(got, id)
+	}
+	sort.Strings(got)
+	want := []string{"asia", "europe", "japan", "monaco", "shikoku", "vatican"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Generate() with --root = %v, want %v", got, want)
+	}
+	if c.Elements["japan"].Formats == nil || c.Elements["shikoku"].Formats == nil {
+		t.Errorf("Generate() with --root haven't updated japan subtree")
+	}
+}
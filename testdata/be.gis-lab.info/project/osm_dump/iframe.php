@@ -0,0 +1,24 @@
+<!DOCTYPE html>
+<html>
+<head>
+<meta http-equiv="Content-Type" content="text/html; charset=utf-8">
+<title>OSM dump</title>
+</head>
+<body>
+<table class="dump">
+<tr><th>Код</th><th>Название</th><th>PBF</th><th>BZ2</th><th>Дата</th><th>Размер</th></tr>
+<tr><td>local</td><td>локальное покрытие</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/local.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/local.osm.bz2">bz2</a></td><td>2019-04-02</td><td>2.9 ГБ</td></tr>
+<tr><td>AM</td><td>Армения</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/AM.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/AM.osm.bz2">bz2</a></td><td>2019-04-02</td><td>21 МБ</td></tr>
+<tr><td>AZ</td><td>Азербайджан</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/AZ.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/AZ.osm.bz2">bz2</a></td><td>2019-04-02</td><td>18 МБ</td></tr>
+<tr><td>BY</td><td>Беларусь</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/BY.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/BY.osm.bz2">bz2</a></td><td>2019-04-02</td><td>196 МБ</td></tr>
+<tr><td>EE</td><td>Эстония</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/EE.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/EE.osm.bz2">bz2</a></td><td>2019-04-02</td><td>77 МБ</td></tr>
+<tr><td>TJ</td><td>Таджикистан</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/TJ.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/TJ.osm.bz2">bz2</a></td><td>2019-04-02</td><td>15 МБ</td></tr>
+<tr><td>TM</td><td>Туркмения</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/TM.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/TM.osm.bz2">bz2</a></td><td>2019-04-02</td><td>8 МБ</td></tr>
+<tr><td>UA</td><td>Украина</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/UA.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/UA.osm.bz2">bz2</a></td><td>2019-04-02</td><td>512 МБ</td></tr>
+<tr><td>UZ</td><td>Узбекистан</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/UZ.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/UZ.osm.bz2">bz2</a></td><td>2019-04-02</td><td>40 МБ</td></tr>
+<tr><td>RU</td><td>Российская Федерация</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/RU.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/RU.osm.bz2">bz2</a></td><td>2019-04-02</td><td>2.4 ГБ</td></tr>
+<tr><td>RU-AD</td><td>Адыгея</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/RU-AD.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/RU-AD.osm.bz2">bz2</a></td><td>2019-04-02</td><td>5 МБ</td></tr>
+<tr><td>RU-AL</td><td>Алтай</td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/RU-AL.osm.pbf">pbf</a></td><td><a href="http://data.gis-lab.info/osm_dump/dump/latest/RU-AL.osm.bz2">bz2</a></td><td>2019-04-02</td><td>9 МБ</td></tr>
+</table>
+</body>
+</html>
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/julien-noblet/download-geofabrik/pkg/geofabrik"
+)
+
+// configError is a problem found in a Config for an element.
+type configError struct {
+	ID  string
+	Msg string
+}
+
+func (e configError) Error() string {
+	return e.ID + ": " + e.Msg
+}
+
+// parentCycle return true if following parents of id loop.
+// Missing parents stop the walk, they are reported elsewhere.
+func parentCycle(c *Config, id string) bool {
+	visited := map[string]bool{}
+	for id != "" {
+		if visited[id] {
+			return true
+		}
+		visited[id] = true
+		id = c.Elements[id].Parent
+	}
+	return false
+}
+
+// validateConfig check c and return all problems found.
+// Elements are checked in sorted order.
+func validateConfig(c *Config) []configError {
+	var errs []configError
+	for _, key := range elementKeys(c.Elements) {
+		e := c.Elements[key]
+		if e.ID == "" {
+			errs = append(errs, configError{key, "id is missing"})
+		} else if e.ID != key {
+			errs = append(errs, configError{key, fmt.Sprintf("id %q don't match key", e.ID)})
+		}
+		if e.HasParent() {
+			if _, ok := c.Elements[e.Parent]; !ok {
+				errs = append(errs, configError{key, fmt.Sprintf("parent %q not exist", e.Parent)})
+			} else if parentCycle(c, key) {
+				errs = append(errs, configError{key, "cycle in parents"})
+			}
+		}
+		seen := map[string]bool{}
+		for _, f := range e.Formats {
+			if seen[f] {
+				errs = append(errs, configError{key, fmt.Sprintf("format %q is listed twice", f)})
+				continue
+			}
+			seen[f] = true
+			if _, ok := c.Formats[f]; !ok {
+				errs = append(errs, configError{key, fmt.Sprintf("format %q not in config formats", f)})
+			}
+			if strings.HasSuffix(f, ".md5") {
+				base := strings.TrimSuffix(f, ".md5")
+				if !stringInSlice(&base, &e.Formats) {
+					errs = append(errs, configError{key, fmt.Sprintf("%q without %q", f, base)})
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// checkURLs make a HEAD request on every URL of valid elements.
+// jobs requests are made in parallel.
+func checkURLs(c *Config, invalid []configError, jobs int) []configError {
+	skip := map[string]bool{}
+	for _, e := range invalid {
+		skip[e.ID] = true // c.URL may loop or fail
+	}
+	type check struct{ id, url string }
+	checks := make(chan check)
+	var mu sync.Mutex
+	var errs []configError
+	var wg sync.WaitGroup
+	if jobs < 1 {
+		jobs = 1
+	}
+	client, clientErr := newClient(clientAuths(c))
+	for i := 0; i < jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ck := range checks {
+				msg := ""
+				err := clientErr
+				if err == nil {
+					var res *http.Response
+					res, err = client.Head(ck.url)
+					if err == nil {
+						res.Body.Close()
+						if res.StatusCode != http.StatusOK {
+							msg = fmt.Sprintf("%s return %d", ck.url, res.StatusCode)
+						}
+					}
+				}
+				if err != nil {
+					msg = fmt.Sprintf("%s %v", ck.url, err)
+				}
+				if msg != "" {
+					mu.Lock()
+					errs = append(errs, configError{ck.id, msg})
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, key := range elementKeys(c.Elements) {
+		if skip[key] {
+			continue
+		}
+		e := c.Elements[key]
+		for _, f := range e.Formats {
+			myURL, err := c.URL(&e, f)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, configError{key, err.Error()})
+				mu.Unlock()
+				continue
+			}
+			checks <- check{key, myURL}
+		}
+	}
+	close(checks)
+	wg.Wait()
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].ID < errs[j].ID })
+	return errs
+}
+
+// asWritten put elements of configFile in c as they are written, before
+// migration fill missing IDs and remove duplicate formats of old files.
+func asWritten(c *Config, configFile string) error {
+	content, err := readConfigFile(configFile)
+	if err != nil {
+		return err
+	}
+	raw := new(Config)
+	if err = geofabrik.UnmarshalConfig(configFile, content, raw); err != nil {
+		return err
+	}
+	for key, e := range raw.Elements {
+		c.Elements[key] = e
+	}
+	return nil
+}
+
+// validateCommand check configFile and write problems in w.
+// Return true if configFile is valid.
+func validateCommand(w io.Writer, configFile string, withURLs bool, jobs int) (bool, error) {
+	c, err := loadConfig(configFile)
+	if err != nil {
+		return false, err
+	}
+	if err = asWritten(c, configFile); err != nil {
+		return false, err
+	}
+	errs := validateConfig(c)
+	if withURLs {
+		errs = append(errs, checkURLs(c, errs, jobs)...)
+	}
+	for _, e := range errs {
+		fmt.Fprintln(w, e.Error())
+	}
+	return len(errs) == 0, nil
+}
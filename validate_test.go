@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func Test_parentCycle(t *testing.T) {
+	c := &Config{Elements: map[string]Element{
+		"a":       {ID: "a", Parent: "b"},
+		"b":       {ID: "b", Parent: "a"},
+		"self":    {ID: "self", Parent: "self"},
+		"europe":  {ID: "europe"},
+		"france":  {ID: "france", Parent: "europe"},
+		"orphan":  {ID: "orphan", Parent: "nowhere"},
+		"child-a": {ID: "child-a", Parent: "a"},
+	}}
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{id: "a", want: true},
+		{id: "self", want: true},
+		{id: "child-a", want: true},
+		{id: "france", want: false},
+		{id: "orphan", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			if got := parentCycle(c, tt.id); got != tt.want {
+				t.Errorf("parentCycle(%s) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validateConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		c    *Config
+		want []configError
+	}{
+		{
+			name: "valid",
+			c: &Config{
+				Formats:  map[string]format{"osm.pbf": {ID: "osm.pbf"}, "osm.pbf.md5": {ID: "osm.pbf.md5"}},
+				Elements: map[string]Element{"europe": {ID: "europe", Formats: []string{"osm.pbf", "osm.pbf.md5"}}, "france": {ID: "france", Parent: "europe"}},
+			},
+		},
+		{
+			name: "all errors",
+			c: &Config{
+				Formats: map[string]format{"osm.pbf": {ID: "osm.pbf"}, "osm.bz2.md5": {ID: "osm.bz2.md5"}},
+				Elements: map[string]Element{
+					"a":      {ID: "a", Parent: "b"},
+					"b":      {ID: "b", Parent: "a"},
+					"bad-id": {ID: "other"},
+					"dup":    {Formats: []string{"osm.pbf", "osm.pbf"}},
+					"md5":    {ID: "md5", Formats: []string{"osm.bz2.md5"}},
+					"orphan": {ID: "orphan", Parent: "nowhere"},
+					"shp":    {ID: "shp", Formats: []string{"osm.pbf", "shp.zip"}},
+				},
+			},
+			want: []configError{
+				{"a", "cycle in parents"},
+				{"b", "cycle in parents"},
+				{"bad-id", "id \"other\" don't match key"},
+				{"dup", "id is missing"},
+				{"dup", "format \"osm.pbf\" is listed twice"},
+				{"md5", "\"osm.bz2.md5\" without \"osm.bz2\""},
+				{"orphan", "parent \"nowhere\" not exist"},
+				{"shp", "format \"shp.zip\" not in config formats"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateConfig(tt.c); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkURLs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "HEAD" {
+			t.Errorf("checkURLs() use %s, want HEAD", r.Method)
+		}
+		if r.URL.Path == "/europe/missing.osm.pbf" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	c := &Config{
+		BaseURL: server.URL,
+		Formats: map[string]format{"osm.pbf": {ID: "osm.pbf", Loc: ".osm.pbf"}},
+		Elements: map[string]Element{
+			"europe":  {ID: "europe", Formats: []string{"osm.pbf"}},
+			"france":  {ID: "france", Formats: []string{"osm.pbf"}, Parent: "europe"},
+			"missing": {ID: "missing", Formats: []string{"osm.pbf"}, Parent: "europe"},
+			"a":       {ID: "a", Formats: []string{"osm.pbf"}, Parent: "a"},
+		},
+	}
+	invalid := []configError{{"a", "cycle in parents"}}
+	want := []configError{{"missing", server.URL + "/europe/missing.osm.pbf return 404"}}
+	for _, jobs := range []int{0, 1, 4} {
+		if got := checkURLs(c, invalid, jobs); !reflect.DeepEqual(got, want) {
+			t.Errorf("checkURLs(jobs=%d) = %v, want %v", jobs, got, want)
+		}
+	}
+}
+
+func Test_validateCommand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download-geofabrik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// without version, ids and duplicates are fixed by migration
+	oldConfig := filepath.Join(dir, "old.yml")
+	ioutil.WriteFile(oldConfig, []byte("formats:\n  osm.pbf:\n    ext: osm.pbf\nelements:\n  monaco:\n    files: [osm.pbf, osm.pbf]\n"), 0644)
+	tests := []struct {
+		name       string
+		configFile string
+		want       bool
+		wantErr    bool
+	}{
+		{name: "geofabrik.yml", configFile: "./geofabrik.yml", want: true},
+		// osm.pbf.md5 is not in formats and "merge" is not an element
+		{name: "openstreetmap.fr.yml", configFile: "./openstreetmap.fr.yml", want: false},
+		{name: "gislab.yml", configFile: "./gislab.yml", want: true},
+		{name: "file not exist", configFile: "./this_file_not_exists", wantErr: true},
+		{name: "missing id and duplicate format", configFile: oldConfig, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			got, err := validateCommand(&buf, tt.configFile, false, 1)
+			if err != nil != tt.wantErr {
+				t.Errorf("validateCommand() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("validateCommand() = %v, want %v\n%s", got, tt.want, buf.String())
+			}
+		})
+	}
+}